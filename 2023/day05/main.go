@@ -9,6 +9,7 @@ import (
 	"slices"
 	"strings"
 
+	"github.com/jmugliston/aoc/interval"
 	"github.com/jmugliston/aoc/parsing"
 )
 
@@ -65,21 +66,6 @@ func seedToLocation(seed int, rangeMaps [][][]int) int {
 	return currentValue
 }
 
-func locationToSeed(location int, rangeMaps [][][]int) int {
-	currentValue := location
-	// Need to go backwards through the ranges
-	for i := len(rangeMaps) - 1; i >= 0; i-- {
-		nextRangeMap := rangeMaps[i]
-		for _, nextRange := range nextRangeMap {
-			if currentValue >= nextRange[0] && currentValue < nextRange[0]+nextRange[2] {
-				currentValue = nextRange[1] + (currentValue - nextRange[0])
-				break
-			}
-		}
-	}
-	return currentValue
-}
-
 func Part1(input string) int {
 	lines := strings.Split(strings.TrimSpace(input), "\n\n")
 
@@ -102,31 +88,19 @@ func Part2(input string) int {
 	seedLine := strings.Split(lines[0], ": ")
 	seedRangeList := parsing.ReadNumbers(seedLine[1])
 
-	seedRanges := [][]int{}
-
-	for i := 0; i <= len(seedRangeList); i = i + 2 {
-		if (i + 1) < len(seedRangeList) {
-			seedRanges = append(seedRanges, []int{seedRangeList[i], seedRangeList[i+1]})
-		}
+	seeds := interval.NewSet()
+	for i := 0; i+1 < len(seedRangeList); i = i + 2 {
+		seeds = seeds.Add(interval.FromLength(seedRangeList[i], seedRangeList[i+1]))
 	}
 
-	rangeMaps := getRangeMaps(lines[1:])
-
-	// Brute force from location 1 to find the the first valid seed
-
-	location := 1
-	for {
-
-		seed := locationToSeed(location, rangeMaps)
-
-		for _, seedRange := range seedRanges {
-			if seed >= seedRange[0] && seed < seedRange[0]+seedRange[1] {
-				return location
-			}
+	// Map the seed ranges through each stage, splitting them where the mappings overlap
+	for _, rangeMap := range getRangeMaps(lines[1:]) {
+		transform := interval.Transform{}
+		for _, nextRange := range rangeMap {
+			transform = append(transform, interval.NewMapping(nextRange[0], nextRange[1], nextRange[2]))
 		}
-
-		location += 1
-
+		seeds = transform.Apply(seeds)
 	}
 
+	return seeds.Min()
 }
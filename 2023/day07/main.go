@@ -11,6 +11,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/jmugliston/aoc/combin"
 	"github.com/jmugliston/aoc/utils"
 )
 
@@ -60,21 +61,13 @@ func getHands(lines []string) []hand {
 	return hands
 }
 
-func cardCounts(cards []string) map[string]int {
-	counts := make(map[string]int)
-	for _, card := range cards {
-		counts[card] += 1
-	}
-	return counts
-}
-
 func isXOfAKind(cards []string, x int) bool {
-	counts := utils.Values(cardCounts(cards))
+	counts := utils.Values(utils.Frequencies(cards))
 	return slices.Contains(counts, x)
 }
 
 func isFullHouse(cards []string) bool {
-	counts := utils.Values(cardCounts(cards))
+	counts := utils.Values(utils.Frequencies(cards))
 	if slices.Contains(counts, 3) && slices.Contains(counts, 2) {
 		return true
 	}
@@ -82,7 +75,7 @@ func isFullHouse(cards []string) bool {
 }
 
 func isTwoPair(cards []string) bool {
-	counts := utils.Values(cardCounts(cards))
+	counts := utils.Values(utils.Frequencies(cards))
 	return len(utils.Filter(counts, func(value int) bool {
 		return value == 2
 	})) == 2
@@ -110,32 +103,21 @@ func getHandValue(cards []string) int {
 	return 1
 }
 
-// Recursive function to replace a card in a hand with all possible replacements
-func replaceAndCombine(cards []string, replace string, with []string) [][]string {
-	idx := utils.IndexOf(len(cards), func(idx int) bool { return cards[idx] == replace })
-
-	options := [][]string{}
-
-	if idx == -1 {
-		options = append(options, cards)
-		return options
-	}
-
-	for _, card := range with {
-		newCards := make([]string, len(cards))
-		copy(newCards, cards)
-		newCards[idx] = card
-		nextOptions := replaceAndCombine(newCards, replace, with)
-		options = append(options, nextOptions...)
+// Try every replacement for the jokers in a hand and take the best value
+func getBestHandValue(cards []string) int {
+	jokers := []int{}
+	for idx, card := range cards {
+		if card == "J" {
+			jokers = append(jokers, idx)
+		}
 	}
 
-	return options
-}
-
-func getBestHandValue(cards []string) int {
-	options := replaceAndCombine(cards, "J", []string{"A", "K", "Q", "T", "9", "8", "7", "6", "5", "4", "3", "2"})
 	best := 0
-	for _, option := range options {
+	for replacements := range combin.Repeat([]string{"A", "K", "Q", "T", "9", "8", "7", "6", "5", "4", "3", "2"}, len(jokers)) {
+		option := slices.Clone(cards)
+		for i, idx := range jokers {
+			option[idx] = replacements[i]
+		}
 		value := getHandValue(option)
 		if value > best {
 			best = value
@@ -3,19 +3,18 @@ package main
 import (
 	"flag"
 	"fmt"
-	"math"
 	"os"
 	"path/filepath"
 	"runtime"
 	"slices"
-	"sort"
 
 	"github.com/jmugliston/aoc/grid"
-	"github.com/jmugliston/aoc/utils"
+	"github.com/jmugliston/aoc/viz"
 )
 
 var partFlag = flag.String("part", "1", "The part of the day to run (1 or 2)")
 var exampleFlag = flag.Bool("example", false, "Use the example instead of the puzzle input")
+var visualizeFlag = flag.Bool("visualize", false, "Print the pipe loop as frames for the visualiser")
 
 func main() {
 	flag.Parse()
@@ -34,6 +33,16 @@ func main() {
 		panic("Could not find the input file")
 	}
 
+	if *visualizeFlag {
+		recorder := viz.NewRecorder(os.Stdout)
+		if *partFlag == "1" {
+			part1(string(input), recorder)
+		} else {
+			part2(string(input), recorder)
+		}
+		return
+	}
+
 	if *partFlag == "1" {
 		fmt.Println(Part1(string(input)))
 	} else {
@@ -124,91 +133,76 @@ func getSteps(maze [][]string, start grid.Point) []grid.Point {
 
 }
 
-func numIntersections(row []string, rowIdx int, pipePositionsInRow []grid.Point, forward bool) int {
-	pipes := utils.Filter(pipePositionsInRow, func(p grid.Point) bool {
-		if forward {
-			return p.X > rowIdx && slices.Contains([]string{"F", "7", "L", "J", "|"}, row[p.X])
-		} else {
-			return p.X < rowIdx && slices.Contains([]string{"F", "7", "L", "J", "|"}, row[p.X])
-		}
-	})
-
-	sort.Slice(pipes, func(i, j int) bool {
-		return pipes[i].X < pipes[j].X
-	})
-
-	intersectChars := []string{}
-	for _, p := range pipes {
-		intersectChars = append(intersectChars, row[p.X])
-	}
-
-	intersectCount := 0
-	for i := 0; i < len(intersectChars); i++ {
-		if intersectChars[i] == "|" {
-			intersectCount += 1
-		}
-		if i > 0 {
-			if intersectChars[i-1] == "L" && intersectChars[i] == "7" {
-				intersectCount += 1
-			}
-			if intersectChars[i-1] == "F" && intersectChars[i] == "J" {
-				intersectCount += 1
-			}
-		}
-	}
-
-	return intersectCount
+func Part1(input string) int {
+	return part1(input, nil)
 }
 
-func Part1(input string) int {
+// Records the loop being followed (in up to 100 frames)
+func part1(input string, recorder *viz.Recorder) int {
 	maze := grid.Parse(input)
 
 	start := findStartPosition(maze)
 
 	steps := getSteps(maze, start)
 
+	if recorder != nil {
+		every := max(len(steps)/100, 1)
+		for i := 0; i < len(steps); i += every {
+			end := min(i+every, len(steps))
+			recorder.Record(maze, fmt.Sprintf("Step %d", end-1), steps[:end]...)
+		}
+	}
+
 	return len(steps) / 2
 }
 
 func Part2(input string) int {
+	return part2(input, nil)
+}
+
+// Records the loop, with the tiles inside it marked with an I
+func part2(input string, recorder *viz.Recorder) int {
 	maze := grid.Parse(input)
 
 	start := findStartPosition(maze)
 
 	steps := getSteps(maze, start)
 
-	var pointsInsideTheLoop []grid.Point
-	for y, row := range maze {
-
-		pipePositionsInRow := utils.Filter(steps, func(p grid.Point) bool {
-			return p.Y == y
-		})
-
-		if len(pipePositionsInRow) == 0 {
-			// No pipes in this row - skip
-			continue
+	if recorder != nil {
+		inside := grid.Copy(maze)
+		for _, p := range insideTiles(maze, steps) {
+			inside.SetPoint(p, "I")
 		}
+		recorder.Record(inside, fmt.Sprintf("%d tiles inside the loop", grid.InteriorPoints(steps)), steps...)
+	}
 
-		for x := range row {
-
-			if (slices.Contains(steps, grid.Point{X: x, Y: y})) {
-				// This position is a pipe - ignore it
-				continue
-			}
-
-			// Number of pipe intersections looking backwards
-			backwardIntersectCount := numIntersections(row, x, pipePositionsInRow, false)
+	return grid.InteriorPoints(steps)
+}
 
-			// Number of pipe intersections looking forwards
-			forwardIntersectCount := numIntersections(row, x, pipePositionsInRow, true)
+// The tiles inside the loop - a tile is inside if there are an odd number of loop pipes
+// heading North on its left (only needed to draw them, as Pick's theorem gives the count)
+func insideTiles(maze grid.StringGrid, steps []grid.Point) []grid.Point {
+	northward := map[grid.Point]bool{}
+	for i, p := range steps {
+		before, after := steps[(i+len(steps)-1)%len(steps)], steps[(i+1)%len(steps)]
+		north := p.Add(grid.North.Vector())
+		northward[p] = before == north || after == north
+	}
 
-			// If the number of intersections (for backwards and forwards) is odd, then the point is inside the loop
-			if math.Mod(float64(backwardIntersectCount), 2) == 1 && math.Mod(float64(forwardIntersectCount), 2) == 1 {
-				pointsInsideTheLoop = append(pointsInsideTheLoop, grid.Point{X: x, Y: y})
+	tiles := []grid.Point{}
+	for y, row := range maze {
+		crossings := 0
+		for x := range row {
+			p := grid.Point{X: x, Y: y}
+			if connectsNorth, onLoop := northward[p]; onLoop {
+				if connectsNorth {
+					crossings++
+				}
+			} else if crossings%2 == 1 {
+				tiles = append(tiles, p)
 			}
-
 		}
 	}
 
-	return len(pointsInsideTheLoop)
+	return tiles
 }
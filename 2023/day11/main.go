@@ -7,9 +7,9 @@ import (
 	"path/filepath"
 	"runtime"
 
+	"github.com/jmugliston/aoc/combin"
 	"github.com/jmugliston/aoc/grid"
 	"github.com/jmugliston/aoc/utils"
-	"gonum.org/v1/gonum/stat/combin"
 )
 
 var partFlag = flag.String("part", "1", "The part of the day to run (1 or 2)")
@@ -100,11 +100,9 @@ func getScaledGalaxies(galaxies []grid.Point, emptyRows []int, emptyCols []int,
 }
 
 func getDistances(galaxies []grid.Point) []int {
-	combinations := combin.Combinations(len(galaxies), 2)
-
 	var distances []int
-	for _, combination := range combinations {
-		distance := grid.ManhattenDistance(galaxies[combination[0]], galaxies[combination[1]])
+	for a, b := range combin.Pairs(galaxies) {
+		distance := grid.ManhattenDistance(a, b)
 		distances = append(distances, distance)
 	}
 
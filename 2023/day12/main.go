@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/jmugliston/aoc/memo"
 	"github.com/jmugliston/aoc/parsing"
 )
 
@@ -72,55 +73,55 @@ func getNextNumBrokenSprings(row string) int {
 	return springCount
 }
 
-var cache map[string]int = make(map[string]int, 1000000)
+type arrangement struct {
+	row string
+	// Number of groups of broken springs already placed
+	placed int
+}
 
-func countPossibleArrangements(row string, pattern []int) int {
-	row = trimLeadingDots(row)
-	row = trimTrailingDots(row)
+// Count the ways the unknown springs in a row can be filled in to match the pattern
+func arrangementCounter(pattern []int) *memo.Cache[arrangement, int] {
+	return memo.NewRecursive(func(countArrangements func(arrangement) int, a arrangement) int {
+		row := trimTrailingDots(trimLeadingDots(a.row))
+		remaining := pattern[a.placed:]
 
-	if row == "" {
-		if len(pattern) > 0 {
-			// Not a valid arrangement
-			return 0
+		if row == "" {
+			if len(remaining) > 0 {
+				// Not a valid arrangement
+				return 0
+			}
+			// Found a valid arrangement
+			return 1
 		}
-		// Found a valid arrangement
-		return 1
-	}
 
-	if len(pattern) == 0 {
-		if strings.Contains(row, "#") {
-			// Not a valid arrangement
-			return 0
+		if len(remaining) == 0 {
+			if strings.Contains(row, "#") {
+				// Not a valid arrangement
+				return 0
+			}
+			// Found a valid arrangement
+			return 1
 		}
-		// Found a valid arrangement
-		return 1
-	}
-
-	key := row + strings.Trim(strings.Join(strings.Fields(fmt.Sprint(pattern)), ","), "[]")
 
-	_, ok := cache[key]
+		arrangements := 0
 
-	if ok {
-		return cache[key]
-	}
-
-	arrangements := 0
+		nextBroken := getNextNumBrokenSprings(row)
 
-	nextBroken := getNextNumBrokenSprings(row)
-
-	if nextBroken > 0 {
-		if nextBroken == pattern[0] {
-			next := row[nextBroken:]
-			arrangements += countPossibleArrangements(next, pattern[1:])
+		if nextBroken > 0 {
+			if nextBroken == remaining[0] {
+				arrangements += countArrangements(arrangement{row: row[nextBroken:], placed: a.placed + 1})
+			}
+		} else if strings.Contains(row, "?") {
+			arrangements += countArrangements(arrangement{row: strings.Replace(row, "?", ".", 1), placed: a.placed})
+			arrangements += countArrangements(arrangement{row: strings.Replace(row, "?", "#", 1), placed: a.placed})
 		}
-	} else if strings.Contains(row, "?") {
-		arrangements += countPossibleArrangements(strings.Replace(row, "?", ".", 1), pattern)
-		arrangements += countPossibleArrangements(strings.Replace(row, "?", "#", 1), pattern)
-	}
 
-	cache[key] = arrangements
+		return arrangements
+	})
+}
 
-	return arrangements
+func countPossibleArrangements(row string, pattern []int) int {
+	return arrangementCounter(pattern).Get(arrangement{row: row})
 }
 
 type Record struct {
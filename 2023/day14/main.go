@@ -6,8 +6,9 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
-	"sort"
 
+	"github.com/jmugliston/aoc/automaton"
+	"github.com/jmugliston/aoc/cycle"
 	"github.com/jmugliston/aoc/grid"
 )
 
@@ -84,78 +85,57 @@ func calculateLoad(g grid.StringGrid) int {
 	return load
 }
 
-func Part1(input string) int {
-
-	rockMap := grid.Parse(input)
-
-	tilted := tiltNorth(rockMap)
-
-	totalLoad := calculateLoad(tilted)
-
-	return totalLoad
+// Each generation every rounded rock with space above it rolls one step North
+func rollNorth(p grid.Point, current string, world automaton.View[string]) string {
+	switch {
+	case current == "." && world.Get(p.Add(grid.South.Vector())) == "O":
+		return "O"
+	case current == "O" && world.Get(p.Add(grid.North.Vector())) == ".":
+		return "."
+	}
+	return current
 }
 
-func Part2(input string) int {
+func Part1(input string) int {
 
 	rockMap := grid.Parse(input)
 
-	loads := []int{}
-	cycleMap := map[int][]int{}
-
-	// Run for enough cycles for a pattern to emerge
-	for i := 0; i < 200; i++ {
-		// North
-		rockMap = tiltNorth(rockMap)
-
-		// West
-		rockMap = rockMap.RotateClockwise()
-		rockMap = tiltNorth(rockMap)
+	// Anything off the map acts like a cube rock
+	tilted := automaton.NewDense(rockMap, "#")
 
-		// South
-		rockMap = rockMap.RotateClockwise()
-		rockMap = tiltNorth(rockMap)
+	automaton.RunUntilStable(tilted, rollNorth, len(rockMap))
 
-		// East
-		rockMap = rockMap.RotateClockwise()
-		rockMap = tiltNorth(rockMap)
+	totalLoad := calculateLoad(tilted.Grid())
 
-		// Rotate back to North
-		rockMap = rockMap.RotateClockwise()
-
-		currentLoad := calculateLoad(rockMap)
-		loads = append(loads, currentLoad)
-
-		cycleMap[currentLoad] = append(cycleMap[currentLoad], i)
-	}
-
-	keys := make([]int, len(cycleMap))
+	return totalLoad
+}
 
-	i := 0
-	for k := range cycleMap {
-		keys[i] = k
-		i++
-	}
+func spinCycle(rockMap grid.StringGrid) grid.StringGrid {
+	// North
+	rockMap = tiltNorth(rockMap)
 
-	sort.Ints(keys)
+	// West
+	rockMap = rockMap.RotateClockwise()
+	rockMap = tiltNorth(rockMap)
 
-	firstLoopNumber := keys[0]
+	// South
+	rockMap = rockMap.RotateClockwise()
+	rockMap = tiltNorth(rockMap)
 
-	nums := cycleMap[firstLoopNumber][:2]
+	// East
+	rockMap = rockMap.RotateClockwise()
+	rockMap = tiltNorth(rockMap)
 
-	cycleLength := nums[1] - nums[0]
+	// Rotate back to North
+	return rockMap.RotateClockwise()
+}
 
-	firstTimeSeenInCycle := cycleMap[firstLoopNumber][0]
+func Part2(input string) int {
 
-	offset := ((1_000_000_000 - firstTimeSeenInCycle) % cycleLength) - 1
+	rockMap := grid.Parse(input)
 
-	// Find the index of the firstTimeSeenInCycle in the loads array
-	idx := -1
-	for i, v := range loads {
-		if v == firstLoopNumber {
-			idx = i
-			break
-		}
-	}
+	// The rocks settle into a loop, so jump straight to the final state
+	spins := cycle.Detect(rockMap, spinCycle, grid.StringGrid.ToString)
 
-	return loads[idx+offset]
+	return calculateLoad(spins.Nth(1_000_000_000))
 }
@@ -60,16 +60,49 @@ func getPerimiterPoints(instructions []Instruction) []grid.Point {
 	return perimiterPoints
 }
 
-func getArea(perimiterPoints []grid.Point) int {
-	area := grid.ShoelaceFormula(perimiterPoints)
+// The corners of the trench, starting (and ending) at 0,0
+func getCorners(instructions []Instruction) []grid.Point {
+	corners := []grid.Point{{X: 0, Y: 0}}
+	for _, i := range instructions {
+		last := corners[len(corners)-1]
+		corners = append(corners, last.Add(i.Direction.Vector().Scale(i.Amount)))
+	}
+	return corners
+}
 
-	// Because the polygon is on a grid and the coordinates are the middle of squares,
-	// we need to use Pick's Theorem to calculate the internal area...
-	// A = i + b/2 - 1
-	// i = A - b/2 + 1
-	internalArea := area - (len(perimiterPoints) / 2) + 1
+const (
+	empty = iota
+	trench
+	outside
+)
 
-	return internalArea + len(perimiterPoints)
+// The trench plus everything inside it, for trenches too big to dig point by point
+// Each corner gets its own row and column (with a border around the outside), so the
+// trench fills whole cells and the outside can be flood filled from the top left
+func getCompressedArea(instructions []Instruction) int {
+	corners := getCorners(instructions)
+
+	xs, ys := []int{}, []int{}
+	for _, p := range corners {
+		xs = append(xs, p.X-1, p.X, p.X+1, p.X+2)
+		ys = append(ys, p.Y-1, p.Y, p.Y+1, p.Y+2)
+	}
+
+	lagoon := grid.NewCompressedGrid(xs, ys, empty)
+	for i := 1; i < len(corners); i++ {
+		lagoon.FillRect(corners[i-1], corners[i], trench)
+	}
+
+	lagoon.FloodFill(grid.Point{X: 0, Y: 0}, outside, func(n int) bool {
+		return n == empty
+	})
+
+	return lagoon.Area(trench) + lagoon.Area(empty)
+}
+
+// The trench plus everything inside it
+func getArea(perimiterPoints []grid.Point) int {
+	return grid.InteriorPoints(perimiterPoints) + grid.BoundaryPoints(perimiterPoints)
 }
 
 func Part1(input string) int {
@@ -140,7 +173,5 @@ func Part2(input string) int {
 		})
 	}
 
-	perimiterPoints := getPerimiterPoints(instructions)
-
-	return getArea(perimiterPoints)
+	return getCompressedArea(instructions)
 }
@@ -2,6 +2,7 @@ package main
 
 import (
 	"os"
+	"strings"
 	"testing"
 )
 
@@ -40,3 +41,21 @@ func TestPart2(t *testing.T) {
 	}
 
 }
+
+func TestGraph(t *testing.T) {
+
+	input, err := os.ReadFile("./input/example3.txt")
+
+	if err != nil {
+		panic("Couldn't find the example file!")
+	}
+
+	expected := `"jm" [label="jm\nconjunction", style=filled, fillcolor="#ffcc66"];`
+
+	result := Graph(string(input), "dot")
+
+	if !strings.Contains(result, expected) {
+		t.Errorf("Expected %v in %v", expected, result)
+	}
+
+}
@@ -7,11 +7,13 @@ import (
 	"path/filepath"
 	"runtime"
 
+	"github.com/jmugliston/aoc/automaton"
 	"github.com/jmugliston/aoc/grid"
 )
 
 var partFlag = flag.String("part", "1", "The part of the day to run (1 or 2)")
 var exampleFlag = flag.Bool("example", false, "Use the example instead of the puzzle input")
+var snapshotsFlag = flag.String("snapshots", "", "Save every step of part 1 as a text file in this folder")
 
 func main() {
 	flag.Parse()
@@ -31,7 +33,7 @@ func main() {
 	}
 
 	if *partFlag == "1" {
-		fmt.Println(Part1(string(input)))
+		fmt.Println(part1(string(input), *snapshotsFlag))
 	} else {
 		fmt.Println(Part2(string(input)))
 	}
@@ -42,8 +44,9 @@ type QueueItem struct {
 	Steps int
 }
 
-func FindNumberOfSteps(plotGrid grid.StringGrid, startPosition grid.Point, maxSteps int, includeOdd bool) map[grid.Point]int {
-	stepMap := make(map[grid.Point]int)
+// The number of steps to each reachable plot (-1 for anywhere else)
+func FindNumberOfSteps(plotGrid grid.StringGrid, startPosition grid.Point, maxSteps int, includeOdd bool) *grid.SparseGrid[int] {
+	stepMap := grid.NewSparseGrid(-1)
 
 	queue := []QueueItem{{Point: startPosition, Steps: 0}}
 
@@ -56,7 +59,7 @@ func FindNumberOfSteps(plotGrid grid.StringGrid, startPosition grid.Point, maxSt
 		}
 
 		if (next.Steps%2) == 0 || includeOdd {
-			stepMap[grid.Point{X: next.Point.X, Y: next.Point.Y}] = next.Steps
+			stepMap.SetPoint(next.Point, next.Steps)
 		}
 
 		nextPoints := grid.Neighbours(next.Point)
@@ -68,7 +71,7 @@ func FindNumberOfSteps(plotGrid grid.StringGrid, startPosition grid.Point, maxSt
 			if plotGrid[point.Y][point.X] == "#" {
 				continue
 			}
-			if _, ok := stepMap[point]; ok {
+			if stepMap.Has(point) {
 				continue
 			}
 			existsInQueue := false
@@ -88,12 +91,42 @@ func FindNumberOfSteps(plotGrid grid.StringGrid, startPosition grid.Point, maxSt
 	return stepMap
 }
 
+// The plots the elf could be on after a step - any garden plot next to one it could be on before
+func step(plotGrid grid.StringGrid) automaton.Rule[string] {
+	return func(p grid.Point, current string, world automaton.View[string]) string {
+		if !plotGrid.IsPointInGrid(p) || plotGrid.GetPoint(p) == "#" {
+			return "."
+		}
+		if automaton.CountNeighbours(world, p, "O", grid.FourConnected) > 0 {
+			return "O"
+		}
+		return "."
+	}
+}
+
 func Part1(input string) int {
+	return part1(input, "")
+}
+
+// Saves each step to the snapshots folder (if there is one)
+func part1(input string, snapshots string) int {
 	plotGrid := grid.Parse(input)
 
 	startPosition := plotGrid.Find("S")
 
-	return len(FindNumberOfSteps(plotGrid, startPosition, 64, false))
+	plots := automaton.NewSparse(grid.NewSparseGrid("."))
+	plots.Set(startPosition, "O")
+
+	automaton.Run(plots, step(plotGrid), 64, func(e automaton.Engine[string]) bool {
+		if snapshots != "" {
+			if err := automaton.SaveSnapshot(e, snapshots, nil); err != nil {
+				panic(err)
+			}
+		}
+		return true
+	})
+
+	return plots.Cells().Len()
 }
 
 func Part2(input string) int {
@@ -117,7 +150,8 @@ func Part2(input string) int {
 	evenFull := 0
 	oddFull := 0
 
-	for _, steps := range stepMap {
+	for _, p := range stepMap.Points() {
+		steps := stepMap.GetPoint(p)
 		if steps%2 == 0 {
 			evenFull++
 		} else {
@@ -9,6 +9,7 @@ import (
 	"slices"
 
 	"github.com/jmugliston/aoc/parsing"
+	"github.com/jmugliston/aoc/xyz"
 )
 
 var partFlag = flag.String("part", "1", "The part of the day to run (1 or 2)")
@@ -38,15 +39,9 @@ func main() {
 	}
 }
 
-type Position struct {
-	x int
-	y int
-	z int
-}
-
 type Brick struct {
 	id          int
-	positions   []Position
+	box         xyz.Box
 	bricksAbove []*Brick
 	bricksBelow []*Brick
 }
@@ -54,137 +49,81 @@ type Brick struct {
 func parseBricks(lines []string) []*Brick {
 	bricks := make([]*Brick, 0)
 	for idx, line := range lines {
-		var startX, startY, startZ int
-		var endX, endY, endZ int
+		var start, end xyz.Coord
 
 		parsed, _ := fmt.Sscanf(
 			line,
 			"%d,%d,%d~%d,%d,%d",
-			&startX, &startY, &startZ,
-			&endX, &endY, &endZ)
+			&start.X, &start.Y, &start.Z,
+			&end.X, &end.Y, &end.Z)
 
 		if parsed != 6 {
 			panic("Could not parse line")
 		}
 
-		brickPositions := make([]Position, 0)
-		for x := startX; x <= endX; x++ {
-			for y := startY; y <= endY; y++ {
-				for z := startZ; z <= endZ; z++ {
-					brickPositions = append(brickPositions, Position{x, y, z})
-				}
-			}
-		}
-
 		bricks = append(bricks, &Brick{
-			id:        idx,
-			positions: brickPositions,
+			id:  idx,
+			box: xyz.NewBox(start, end),
 		})
 	}
 
 	return bricks
 }
 
-// Create a map of all brick positions
-func mapAllBrickPositions(bricks []*Brick) map[Position]*Brick {
-	brickPositions := make(map[Position]*Brick)
-	for _, brick := range bricks {
-		for _, position := range brick.positions {
-			brickPositions[position] = brick
-		}
-	}
-
-	return brickPositions
-}
-
-// Check if a brick can fall by checking if the position below is empty
-func canBrickFall(brick *Brick, positionMap map[Position]*Brick) bool {
-	for _, position := range brick.positions {
+// One unit down
+var down = xyz.Coord{Z: -1}
 
-		// Is position at the bottom?
-		if (position.z - 1) <= 0 {
-			return false
-		}
+// Check if a brick can fall by checking if the space below is empty
+func canBrickFall(brick *Brick, space *xyz.VoxelGrid[*Brick]) bool {
+	// Is the brick at the bottom?
+	if brick.box.Min.Z <= 1 {
+		return false
+	}
 
+	for _, position := range brick.box.Shift(down).Coords() {
 		// Is position occupied by another brick
-		if brickToCheck, ok := positionMap[Position{
-			x: position.x,
-			y: position.y,
-			z: position.z - 1,
-		}]; ok {
-			if brickToCheck.id != brick.id {
-				return false
-			}
+		if other := space.Get(position); other != nil && other != brick {
+			return false
 		}
-
 	}
+
 	return true
 }
 
-// Stabilise the bricks by moving them down until they can't fall any further
-func stabiliseBricks(bricks []*Brick, brickPositions map[Position]*Brick) []*Brick {
-	keepGoing := true
+// Stabilise the bricks by dropping them (lowest first) until they can't fall any further
+func stabiliseBricks(bricks []*Brick) *xyz.VoxelGrid[*Brick] {
+	slices.SortFunc(bricks, func(a, b *Brick) int {
+		return a.box.Min.Z - b.box.Min.Z
+	})
 
-	for keepGoing {
-		keepGoing = false
-		for i := 0; i < len(bricks); i++ {
-			canFall := canBrickFall(bricks[i], brickPositions)
-			if canFall {
-				keepGoing = true
-
-				prevPositions := bricks[i].positions
-
-				// Reset the brick positions
-				bricks[i].positions = make([]Position, 0)
-
-				for _, prevPosition := range prevPositions {
-
-					// Remove the previous position
-					delete(brickPositions, prevPosition)
-
-					// Add the new position
-					brickPositions[Position{
-						x: prevPosition.x,
-						y: prevPosition.y,
-						z: prevPosition.z - 1,
-					}] = bricks[i]
-
-					// Remap the brick position (1 unit down)
-					bricks[i].positions = append(bricks[i].positions, Position{
-						x: prevPosition.x,
-						y: prevPosition.y,
-						z: prevPosition.z - 1,
-					})
-				}
-			}
+	space := xyz.NewVoxelGrid[*Brick](nil)
+
+	for _, brick := range bricks {
+		for canBrickFall(brick, space) {
+			brick.box = brick.box.Shift(down)
+		}
+		for _, position := range brick.box.Coords() {
+			space.Set(position, brick)
 		}
 	}
 
-	return bricks
+	return space
 }
 
-// Check each brick for other bricks directly above / below
+// Check each brick for other bricks directly below
 // and add them as dependencies.
-func mapBrickDependencies(bricks []*Brick) {
+func mapBrickDependencies(bricks []*Brick, space *xyz.VoxelGrid[*Brick]) {
 	for _, brick := range bricks {
-		for _, brickPosition := range brick.positions {
-			for _, otherBrick := range bricks {
-				if brick.id == otherBrick.id {
-					continue
-				}
-
-				if (slices.Contains(otherBrick.positions, Position{
-					x: brickPosition.x,
-					y: brickPosition.y,
-					z: brickPosition.z + 1,
-				})) {
-					if !slices.Contains(brick.bricksAbove, otherBrick) {
-						brick.bricksAbove = append(brick.bricksAbove, otherBrick)
-					}
-					if !slices.Contains(otherBrick.bricksBelow, brick) {
-						otherBrick.bricksBelow = append(otherBrick.bricksBelow, brick)
-					}
-				}
+		for _, position := range brick.box.Shift(down).Coords() {
+			below := space.Get(position)
+			if below == nil || below == brick {
+				continue
+			}
+			if !slices.Contains(below.bricksAbove, brick) {
+				below.bricksAbove = append(below.bricksAbove, brick)
+			}
+			if !slices.Contains(brick.bricksBelow, below) {
+				brick.bricksBelow = append(brick.bricksBelow, below)
 			}
 		}
 	}
@@ -234,19 +173,16 @@ func disintegrateBricks(brick *Brick) int {
 }
 
 func Part1(input string) int {
-
 	lines := parsing.ReadLines(input)
 
 	bricks := parseBricks(lines)
 
-	brickPositions := mapAllBrickPositions(bricks)
-
-	stableBricks := stabiliseBricks(bricks, brickPositions)
+	space := stabiliseBricks(bricks)
 
-	mapBrickDependencies(stableBricks)
+	mapBrickDependencies(bricks, space)
 
 	safeToMoveCount := 0
-	for _, brick := range stableBricks {
+	for _, brick := range bricks {
 		if isBrickSafeToRemove(brick) {
 			safeToMoveCount++
 		}
@@ -260,14 +196,12 @@ func Part2(input string) int {
 
 	bricks := parseBricks(lines)
 
-	brickPositions := mapAllBrickPositions(bricks)
+	space := stabiliseBricks(bricks)
 
-	stableBricks := stabiliseBricks(bricks, brickPositions)
-
-	mapBrickDependencies(stableBricks)
+	mapBrickDependencies(bricks, space)
 
 	totalBricksDisintegrated := 0
-	for _, brick := range stableBricks {
+	for _, brick := range bricks {
 		// Disintegrate the brick and all bricks above it (-1 to account for the brick itself)
 		totalBricksDisintegrated += disintegrateBricks(brick) - 1
 	}
@@ -3,7 +3,6 @@ package main
 import (
 	"flag"
 	"fmt"
-	"math/big"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -92,11 +91,8 @@ func parseHailstones(lines []string) []hailstone {
 
 }
 
-func findLinePlaneIntersection(p0 bigxyz.Coord, n bigxyz.Coord, stone hailstoneBig) (bigxyz.Coord, *big.Int) {
-	d := bigInt.Div(
-		bigxyz.Dot(bigxyz.Minus(p0, stone.position), n),
-		bigxyz.Dot(stone.velocity, n),
-	)
+func findLinePlaneIntersection(p0 bigxyz.Coord, n bigxyz.Coord, stone hailstoneBig) (bigxyz.Coord, bigInt.Int) {
+	d := bigxyz.Dot(bigxyz.Minus(p0, stone.position), n).Div(bigxyz.Dot(stone.velocity, n))
 	return bigxyz.Plus(stone.position, bigxyz.Multiply(stone.velocity, d)), d
 }
 
@@ -184,11 +180,7 @@ func Part1(input string, example bool) int {
 }
 
 func convertToCoordBig(coord xyz.Coord) bigxyz.Coord {
-	return bigxyz.Coord{
-		X: big.NewInt(int64(coord.X)),
-		Y: big.NewInt(int64(coord.Y)),
-		Z: big.NewInt(int64(coord.Z)),
-	}
+	return bigxyz.New(coord.X, coord.Y, coord.Z)
 }
 
 // Credit to this comment on Reddit for the methodology of the solution
@@ -226,17 +218,17 @@ func Part2(input string) int {
 
 	// Take two more hailstones and find the intersections their lines with the plane
 	intersection1position, intersection1time := findLinePlaneIntersection(
-		bigxyz.Coord{X: big.NewInt(0), Y: big.NewInt(0), Z: big.NewInt(0)},
+		bigxyz.Coord{},
 		n,
 		relativeHailstones[2],
 	)
 	intersection2position, intersection2time := findLinePlaneIntersection(
-		bigxyz.Coord{X: big.NewInt(0), Y: big.NewInt(0), Z: big.NewInt(0)},
+		bigxyz.Coord{},
 		n,
 		relativeHailstones[3],
 	)
 
-	timeDiff := bigInt.Sub(intersection2time, intersection1time)
+	timeDiff := intersection2time.Sub(intersection1time)
 
 	// This is the relative rock velocity (velocity = distance / time)
 	relativeRockVelocity := bigxyz.Divide(bigxyz.Minus(intersection2position, intersection1position), timeDiff)
@@ -247,7 +239,5 @@ func Part2(input string) int {
 	// Convert back to absolute position
 	rockPosition := bigxyz.Plus(relativeRockPosition, convertToCoordBig(reference.position))
 
-	result := bigInt.Add(bigInt.Add(rockPosition.X, rockPosition.Y), rockPosition.Z)
-
-	return int(result.Int64())
+	return rockPosition.X.Add(rockPosition.Y).Add(rockPosition.Z).MustInt()
 }
@@ -61,10 +61,7 @@ func Part2(input string) int {
 
 	list_a := utils.EveryNthElement(numbers, 2)
 
-	freq_map_b := map[int]int{}
-	for _, num := range utils.EveryNthElement(numbers[1:], 2) {
-		freq_map_b[num]++
-	}
+	freq_map_b := utils.Frequencies(utils.EveryNthElement(numbers[1:], 2))
 
 	similarityScore := 0
 	for _, num := range list_a {
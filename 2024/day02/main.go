@@ -51,8 +51,8 @@ func isDiffSafe(a int, b int, isIncreasing bool) bool {
 
 func isReportSafe(line []int) bool {
 	isIncreasing := line[1] > line[0]
-	for i := 1; i < len(line); i++ {
-		if !isDiffSafe(line[i-1], line[i], isIncreasing) {
+	for pair := range utils.Windows(line, 2) {
+		if !isDiffSafe(pair[0], pair[1], isIncreasing) {
 			return false
 		}
 	}
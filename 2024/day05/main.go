@@ -7,10 +7,10 @@ import (
 	"path/filepath"
 	"runtime"
 	"slices"
-	"sort"
 	"strconv"
 	"strings"
 
+	"github.com/jmugliston/aoc/graph"
 	"github.com/jmugliston/aoc/parsing"
 	"github.com/jmugliston/aoc/utils"
 )
@@ -67,20 +67,29 @@ func parse(input string) ([][]int, [][]int) {
 	return rules, allUpdates
 }
 
+// Order an update using only the rules between its own pages (all of the rules together contain cycles)
 func sortUpdate(update []int, rules [][]int) []int {
-	sorted := make([]int, len(update))
-	copy(sorted, update)
-
-	sort.Slice(sorted, func(i, j int) bool {
-		for _, rule := range rules {
-			if sorted[i] == rule[0] && sorted[j] == rule[1] {
-				if i > j {
-					return false
-				}
-			}
+	g := graph.Graph{}
+	for _, page := range update {
+		g.AddNode(strconv.Itoa(page))
+	}
+
+	for _, rule := range rules {
+		if slices.Contains(update, rule[0]) && slices.Contains(update, rule[1]) {
+			before, after := strconv.Itoa(rule[0]), strconv.Itoa(rule[1])
+			g.AddEdge(before+"|"+after, before, after, []string{})
 		}
-		return true
-	})
+	}
+
+	order, err := g.TopologicalSort()
+	if err != nil {
+		panic(err)
+	}
+
+	sorted := make([]int, len(order))
+	for i, page := range order {
+		sorted[i], _ = strconv.Atoi(page)
+	}
 
 	return sorted
 }
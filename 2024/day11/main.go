@@ -8,6 +8,7 @@ import (
 	"runtime"
 	"strconv"
 
+	"github.com/jmugliston/aoc/memo"
 	"github.com/jmugliston/aoc/parsing"
 )
 
@@ -39,49 +40,39 @@ func main() {
 }
 
 type Stone struct {
-	value  int
+	value int
+	// Blinks still to go
 	blinks int
 }
 
-var cache = make(map[Stone]int)
-
-func runRules(stone Stone, maxBlinks int) int {
-	if stone.blinks == maxBlinks {
+// Number of stones a stone turns into after its remaining blinks
+var stoneCounter = memo.NewRecursive(func(countStones func(Stone) int, stone Stone) int {
+	if stone.blinks == 0 {
 		return 1
 	}
 
-	if val, ok := cache[stone]; ok {
-		return val
-	}
-
-	var result int
-
 	numString := strconv.Itoa(stone.value)
 
 	if stone.value == 0 {
 		// Rule #1
-		result = runRules(Stone{value: 1, blinks: stone.blinks + 1}, maxBlinks)
+		return countStones(Stone{value: 1, blinks: stone.blinks - 1})
 	} else if len(numString)%2 == 0 {
 		// Rule #2
 		newStoneValue1, _ := strconv.Atoi(numString[:len(numString)/2])
 		newStoneValue2, _ := strconv.Atoi(numString[len(numString)/2:])
-		result = runRules(Stone{value: newStoneValue1, blinks: stone.blinks + 1}, maxBlinks) + runRules(Stone{value: newStoneValue2, blinks: stone.blinks + 1}, maxBlinks)
-	} else {
-		// Rule #3
-		result = runRules(Stone{value: stone.value * 2024, blinks: stone.blinks + 1}, maxBlinks)
+		return countStones(Stone{value: newStoneValue1, blinks: stone.blinks - 1}) + countStones(Stone{value: newStoneValue2, blinks: stone.blinks - 1})
 	}
 
-	cache[stone] = result
-
-	return result
-}
+	// Rule #3
+	return countStones(Stone{value: stone.value * 2024, blinks: stone.blinks - 1})
+})
 
 func Part1(input string) int {
 	stones := parsing.ReadNumbers(input)
 
 	total := 0
 	for i := 0; i < len(stones); i++ {
-		total += runRules(Stone{value: stones[i], blinks: 0}, 25)
+		total += stoneCounter.Get(Stone{value: stones[i], blinks: 25})
 	}
 
 	return total
@@ -92,7 +83,7 @@ func Part2(input string) int {
 
 	total := 0
 	for i := 0; i < len(stones); i++ {
-		total += runRules(Stone{value: stones[i], blinks: 0}, 75)
+		total += stoneCounter.Get(Stone{value: stones[i], blinks: 75})
 	}
 
 	return total
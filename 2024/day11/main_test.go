@@ -31,7 +31,7 @@ func TestPart2(t *testing.T) {
 		panic("Couldn't find the example file!")
 	}
 
-	expected := 55312
+	expected := 65601038650482
 
 	result := Part2(string(input))
 
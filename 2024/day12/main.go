@@ -37,191 +37,14 @@ func main() {
 	}
 }
 
-type Region struct {
-	Name    string
-	Tiles   []grid.Point
-	Edges   int
-	Corners int
-}
-
-func getTileEdges(tile grid.Point, regionMap grid.StringGrid) int {
-	edges := 0
-
-	points := tile.Neighbours()
-
-	for _, point := range []grid.Point{points.North, points.East, points.South, points.West} {
-		if regionMap.IsPointInGrid(point) {
-			if regionMap[point.Y][point.X] != regionMap[tile.Y][tile.X] {
-				edges++
-			}
-		} else {
-			edges++
-		}
-	}
-
-	return edges
-}
-
-func getTileCorners(tile grid.Point, regionMap grid.StringGrid) int {
-	region := regionMap.GetPoint(tile)
-
-	adjacentPoints := tile.Neighbours()
-
-	n := regionMap.GetPoint(adjacentPoints.North)
-	ne := regionMap.GetPoint(adjacentPoints.NorthEast)
-	e := regionMap.GetPoint(adjacentPoints.East)
-	se := regionMap.GetPoint(adjacentPoints.SouthEast)
-	s := regionMap.GetPoint(adjacentPoints.South)
-	sw := regionMap.GetPoint(adjacentPoints.SouthWest)
-	w := regionMap.GetPoint(adjacentPoints.West)
-	nw := regionMap.GetPoint(adjacentPoints.NorthWest)
-
-	// 4 types of corner cases (can be inside and outside corners)
-
-	// Top left
-	// xx.
-	// x..
-
-	// Top right
-	// .xx
-	// ..x
-
-	// Bottom left
-	// x..
-	// xx.
-
-	// Bottom right
-	// ..x
-	// .xx
-
-	matches := 0
-	for _, point := range []grid.Point{adjacentPoints.North, adjacentPoints.East, adjacentPoints.South, adjacentPoints.West} {
-		if regionMap.GetPoint(point) == region {
-			matches++
-		}
-	}
-
-	if matches == 1 {
-		// Special case for end points (only 1 adjacent tile)
-		return 2
-	}
-
-	corners := 0
-
-	// Top left
-	if e == region && s == region {
-		if w != region && n != region {
-			// Outside
-			corners++
-		}
-		if se != region {
-			// Inside
-			corners++
-		}
-	}
-
-	// Top right
-	if w == region && s == region {
-		if e != region && n != region {
-			// Outside
-			corners++
-		}
-		if sw != region {
-			// Inside
-			corners++
-		}
-	}
-
-	// Bottom right
-	if w == region && n == region {
-		if s != region && e != region {
-			// Outside
-			corners++
-		}
-		if nw != region {
-			// Inside
-			corners++
-		}
-	}
-
-	// Bottom left
-	if e == region && n == region {
-		if s != region && w != region {
-			// Outside
-			corners++
-		}
-		if ne != region {
-			// Inside
-			corners++
-		}
-	}
-
-	return corners
-}
-
-func getRegion(startPoint grid.Point, regionMap grid.StringGrid) Region {
-	visited := make(map[grid.Point]bool)
-
-	region := Region{
-		Name:  regionMap[startPoint.Y][startPoint.X],
-		Tiles: []grid.Point{},
-		Edges: 0,
-	}
-
-	queue := []grid.Point{startPoint}
-
-	// Flood fill to get region
-	for len(queue) > 0 {
-		current := queue[0]
-		queue = queue[1:]
-
-		if visited[current] {
-			continue
-		}
-
-		visited[current] = true
-
-		region.Tiles = append(region.Tiles, current)
-		region.Edges += getTileEdges(current, regionMap)
-		region.Corners += getTileCorners(current, regionMap)
-
-		points := current.Neighbours()
-
-		for _, point := range []grid.Point{points.North, points.East, points.South, points.West} {
-			if regionMap.IsPointInGrid(point) && !visited[point] && regionMap[point.Y][point.X] == region.Name {
-				queue = append(queue, point)
-			}
-		}
-	}
-
-	if len(region.Tiles) == 1 {
-		region.Corners = 4
-	}
-
-	return region
-
-}
-
 func Part1(input string) int {
 	regionMap := grid.Parse(input)
 
-	total := 0
-
-	visited := make(map[grid.Point]bool)
-	for y, row := range regionMap {
-		for x := range row {
-			if visited[grid.Point{X: x, Y: y}] {
-				continue
-			}
-
-			region := getRegion(grid.Point{X: x, Y: y}, regionMap)
+	regions, _ := grid.Regions(regionMap, grid.FourConnected)
 
-			for _, point := range region.Tiles {
-				visited[point] = true
-			}
-
-			total += region.Edges * len(region.Tiles)
-		}
+	total := 0
+	for _, region := range regions {
+		total += region.Perimeter() * region.Area()
 	}
 
 	return total
@@ -230,23 +53,11 @@ func Part1(input string) int {
 func Part2(input string) int {
 	regionMap := grid.Parse(input)
 
-	total := 0
-
-	visited := make(map[grid.Point]bool)
-	for y, row := range regionMap {
-		for x := range row {
-			if visited[grid.Point{X: x, Y: y}] {
-				continue
-			}
+	regions, _ := grid.Regions(regionMap, grid.FourConnected)
 
-			region := getRegion(grid.Point{X: x, Y: y}, regionMap)
-
-			for _, point := range region.Tiles {
-				visited[point] = true
-			}
-
-			total += region.Corners * len(region.Tiles)
-		}
+	total := 0
+	for _, region := range regions {
+		total += region.Sides() * region.Area()
 	}
 
 	return total
@@ -3,16 +3,13 @@ package main
 import (
 	"flag"
 	"fmt"
-	"math"
 	"os"
 	"path/filepath"
-	"regexp"
 	"runtime"
-	"strconv"
-	"strings"
 
 	"github.com/jmugliston/aoc/grid"
-	"gonum.org/v1/gonum/mat"
+	"github.com/jmugliston/aoc/linalg"
+	"github.com/jmugliston/aoc/parsing"
 )
 
 var partFlag = flag.String("part", "1", "The part of the day to run (1 or 2)")
@@ -43,60 +40,28 @@ func main() {
 }
 
 type Game struct {
-	A     grid.Point
-	B     grid.Point
-	Prize grid.Point
+	A     grid.Point `find:"Button A: (.*)" regex:"X\\+(\\d+), Y\\+(\\d+)"`
+	B     grid.Point `find:"Button B: (.*)" regex:"X\\+(\\d+), Y\\+(\\d+)"`
+	Prize grid.Point `find:"Prize: (.*)" regex:"X=(\\d+), Y=(\\d+)"`
 }
 
 func parseInput(input string) []Game {
-	games := make([]Game, 0)
+	var games []Game
 
-	rawGames := strings.Split(strings.TrimSpace(input), "\n\n")
-
-	for _, rawGame := range rawGames {
-		lines := strings.Split(rawGame, "\n")
-		var game Game
-		for idx, line := range lines {
-			split := strings.Split(line, ":")
-			subSplit := strings.Split(split[1], ",")
-
-			regex := regexp.MustCompile(`(X\+|Y\+|X=|Y=)(\d+)`)
-
-			xStr := regex.FindStringSubmatch(subSplit[0])[2]
-			yStr := regex.FindStringSubmatch(subSplit[1])[2]
-
-			x, _ := strconv.Atoi(xStr)
-			y, _ := strconv.Atoi(yStr)
-
-			if idx == 0 {
-				game.A = grid.Point{X: x, Y: y}
-			} else if idx == 1 {
-				game.B = grid.Point{X: x, Y: y}
-			} else {
-				game.Prize = grid.Point{X: x, Y: y}
-			}
-		}
-		games = append(games, game)
-	}
+	parsing.MustUnmarshal(input, &games)
 
 	return games
 }
 
-func solveLinearEquation(x1, x2, y1, y2, resX, resY float64) (float64, float64) {
-	// Create a matrix A and a vector b
-	A := mat.NewDense(2, 2, []float64{x1, x2, y1, y2})
-	b := mat.NewVecDense(2, []float64{resX, resY})
-
-	var x mat.VecDense
-	if err := x.SolveVec(A, b); err != nil {
-		panic("Could not solve the equations")
-	}
-
-	// Round to 3 decimal places to avoid precision errors
-	finalX := math.Round(x.At(0, 0)*1000) / 1000
-	finalY := math.Round(x.At(1, 0)*1000) / 1000
+// The number of A and B presses to reach the prize, if it can be reached
+func solve(game Game) ([]int, bool) {
+	presses, err := linalg.SolveInts(
+		[][]int{{game.A.X, game.B.X}, {game.A.Y, game.B.Y}},
+		[]int{game.Prize.X, game.Prize.Y},
+	)
 
-	return finalX, finalY
+	// Only whole numbers of presses are valid
+	return presses, err == nil
 }
 
 func Part1(input string) int {
@@ -104,11 +69,8 @@ func Part1(input string) int {
 
 	total := 0
 	for _, game := range games {
-		x, y := solveLinearEquation(float64(game.A.X), float64(game.B.X), float64(game.A.Y), float64(game.B.Y), float64(game.Prize.X), float64(game.Prize.Y))
-
-		// Both x and y must be integers for a valid solution
-		if x == float64(int64(x)) && y == float64(int64(y)) {
-			total += ((int(x) * 3) + int(y))
+		if presses, ok := solve(game); ok {
+			total += (presses[0] * 3) + presses[1]
 		}
 	}
 
@@ -123,10 +85,8 @@ func Part2(input string) int {
 		game.Prize.X += 10000000000000
 		game.Prize.Y += 10000000000000
 
-		x, y := solveLinearEquation(float64(game.A.X), float64(game.B.X), float64(game.A.Y), float64(game.B.Y), float64(game.Prize.X), float64(game.Prize.Y))
-
-		if x == float64(int64(x)) && y == float64(int64(y)) {
-			total += ((int(x) * 3) + int(y))
+		if presses, ok := solve(game); ok {
+			total += (presses[0] * 3) + presses[1]
 		}
 	}
 
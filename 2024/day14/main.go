@@ -6,15 +6,15 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
-	"strconv"
-	"strings"
 
 	"github.com/jmugliston/aoc/grid"
 	"github.com/jmugliston/aoc/parsing"
+	"github.com/jmugliston/aoc/viz"
 )
 
 var partFlag = flag.String("part", "1", "The part of the day to run (1 or 2)")
 var exampleFlag = flag.Bool("example", false, "Use the example instead of the puzzle input")
+var visualizeFlag = flag.Bool("visualize", false, "Print the robot positions as frames for the visualiser")
 
 func main() {
 	flag.Parse()
@@ -33,6 +33,16 @@ func main() {
 		panic("Could not find the input file")
 	}
 
+	if *visualizeFlag {
+		recorder := viz.NewRecorder(os.Stdout)
+		if *partFlag == "1" {
+			part1(string(input), *exampleFlag, recorder)
+		} else {
+			part2(string(input), false, recorder)
+		}
+		return
+	}
+
 	if *partFlag == "1" {
 		fmt.Println(Part1(string(input), *exampleFlag))
 	} else {
@@ -52,15 +62,8 @@ func parseRobots(input string) []*Robot {
 	lines := parsing.ReadLines(input)
 
 	for _, line := range lines {
-		split := strings.Split(line, " ")
-
-		position := strings.Split(strings.Split(split[0], "=")[1], ",")
-		velocity := strings.Split(strings.Split(split[1], "=")[1], ",")
-
-		x, _ := strconv.Atoi(position[0])
-		y, _ := strconv.Atoi(position[1])
-		vx, _ := strconv.Atoi(velocity[0])
-		vy, _ := strconv.Atoi(velocity[1])
+		var x, y, vx, vy int
+		parsing.MustScan(line, "p=%d,%d v=%d,%d", &x, &y, &vx, &vy)
 
 		robots = append(robots, &Robot{grid.Point{X: x, Y: y}, vx, vy})
 	}
@@ -69,30 +72,9 @@ func parseRobots(input string) []*Robot {
 }
 
 func (robot *Robot) move(boundary []int) Robot {
-	boundaryX := boundary[0]
-	boundaryY := boundary[1]
-
-	newPosX := (robot.point.X + robot.vx) % boundaryX
-	newPosY := (robot.point.Y + robot.vy) % boundaryY
+	velocity := grid.Point{X: robot.vx, Y: robot.vy}
 
-	if newPosX < 0 {
-		newPosX = boundaryX + newPosX
-	}
-
-	if newPosX > boundaryX {
-		newPosX = newPosX - boundaryX
-	}
-
-	if newPosY < 0 {
-		newPosY = boundaryY + newPosY
-	}
-
-	if newPosY > boundaryY {
-		newPosY = newPosY - boundaryY
-	}
-
-	robot.point.X = newPosX
-	robot.point.Y = newPosY
+	robot.point = robot.point.Add(velocity).Mod(grid.Point{X: boundary[0], Y: boundary[1]})
 
 	return *robot
 }
@@ -142,7 +124,29 @@ func writeMapToFile(robotMap grid.StringGrid) {
 	}
 }
 
+func drawRobots(robots []*Robot, width int, height int) grid.StringGrid {
+	robotMap := grid.InitialiseStringGrid(width, height, ".")
+	for _, robot := range robots {
+		robotMap.SetPoint(robot.point, "#")
+	}
+	return robotMap
+}
+
+func safetyFactor(robots []*Robot, boundary []int) int {
+	quadrantMap := map[string]int{}
+	for _, robot := range robots {
+		quadrantMap[robot.getQuadrant(boundary)] += 1
+	}
+
+	return quadrantMap["NW"] * quadrantMap["NE"] * quadrantMap["SW"] * quadrantMap["SE"]
+}
+
 func Part1(input string, example bool) int {
+	return part1(input, example, nil)
+}
+
+// Records the robots after every second
+func part1(input string, example bool, recorder *viz.Recorder) int {
 	robots := parseRobots(input)
 
 	height := 103
@@ -159,17 +163,21 @@ func Part1(input string, example bool) int {
 		for _, robot := range robots {
 			robot.move(boundary)
 		}
+		if recorder != nil {
+			recorder.Record(drawRobots(robots, width, height), fmt.Sprintf("Second %d", i+1))
+		}
 	}
 
-	quadrantMap := map[string]int{}
-	for _, robot := range robots {
-		quadrantMap[robot.getQuadrant(boundary)] += 1
-	}
-
-	return quadrantMap["NW"] * quadrantMap["NE"] * quadrantMap["SW"] * quadrantMap["SE"]
+	return safetyFactor(robots, boundary)
 }
 
 func Part2(input string, writeFile bool) int {
+	return part2(input, writeFile, nil)
+}
+
+// Records each second where the safety factor drops to a new low (the robots bunching up),
+// ending with the Christmas tree and its long line of robots highlighted
+func part2(input string, writeFile bool, recorder *viz.Recorder) int {
 	robots := parseRobots(input)
 
 	height := 103
@@ -187,15 +195,17 @@ func Part2(input string, writeFile bool) int {
 
 	boundary := []int{width, height}
 
+	lowestSafetyFactor := -1
+
 	for i := 0; i < 10000; i++ {
 		for _, robot := range robots {
 			robot.move(boundary)
 			robotMap.SetPoint(robot.point, "#")
 		}
 
-		for _, row := range robotMap {
+		for y, row := range robotMap {
 			count := 0
-			for _, point := range row {
+			for j, point := range row {
 				if point != "#" {
 					count = 0
 					continue
@@ -208,11 +218,24 @@ func Part2(input string, writeFile bool) int {
 					if writeFile {
 						writeMapToFile(robotMap)
 					}
+					line := []grid.Point{}
+					for x := j - count + 1; x <= j; x++ {
+						line = append(line, grid.Point{X: x, Y: y})
+					}
+					recorder.Record(robotMap, fmt.Sprintf("Second %d - Christmas tree", i+1), line...)
 					return i + 1
 				}
 			}
 		}
 
+		if recorder != nil {
+			factor := safetyFactor(robots, boundary)
+			if lowestSafetyFactor == -1 || factor < lowestSafetyFactor {
+				lowestSafetyFactor = factor
+				recorder.Record(robotMap, fmt.Sprintf("Second %d - safety factor %d", i+1, factor))
+			}
+		}
+
 		// Reset the robot map
 		for _, robot := range robots {
 			robotMap.SetPoint(robot.point, ".")
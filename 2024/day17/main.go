@@ -3,17 +3,18 @@ package main
 import (
 	"flag"
 	"fmt"
-	"math"
 	"os"
 	"path/filepath"
 	"runtime"
-	"slices"
 	"strconv"
 	"strings"
+
+	"github.com/jmugliston/aoc/vm"
 )
 
 var partFlag = flag.String("part", "1", "The part of the day to run (1 or 2)")
 var exampleFlag = flag.Bool("example", false, "Use the example instead of the puzzle input")
+var disassembleFlag = flag.Bool("disassemble", false, "Print the program as readable instructions")
 
 func main() {
 	flag.Parse()
@@ -32,6 +33,12 @@ func main() {
 		panic("Could not find the input file")
 	}
 
+	if *disassembleFlag {
+		_, program := parseInput(string(input))
+		fmt.Print(newMachine(program).Disassemble())
+		return
+	}
+
 	if *partFlag == "1" {
 		fmt.Println(Part1(string(input)))
 	} else {
@@ -62,178 +69,81 @@ func parseInput(input string) (map[string]int, []int) {
 	return registers, program
 }
 
-func getComboOperand(registers *map[string]int, operand int) int {
-	if operand < 4 {
-		return operand
-	}
-
-	if operand == 4 {
-		return (*registers)["A"]
-	}
-
-	if operand == 5 {
-		return (*registers)["B"]
-	}
-
-	if operand == 6 {
-		return (*registers)["C"]
-	}
-
-	return -1
-}
-
-func adv(registers *map[string]int, operand int) int {
-	val := (*registers)["A"] / (int(math.Pow(float64(2), float64(getComboOperand(registers, operand)))))
-	(*registers)["A"] = val
-	return val
-}
-
-func bxl(registers *map[string]int, operand int) int {
-	val := (*registers)["B"] ^ operand
-	(*registers)["B"] = val
-	return val
-}
-
-func bst(registers *map[string]int, operand int) int {
-	calc := getComboOperand(registers, operand) % 8
-
-	base3Str := strconv.FormatInt(int64(calc), 3)
-
-	for len(base3Str) < 3 {
-		base3Str = "0" + base3Str
-	}
-
-	base3Str = base3Str[len(base3Str)-3:]
-
-	base3Int, _ := strconv.ParseInt(base3Str, 3, 64)
-
-	val := int(base3Int)
-
-	(*registers)["B"] = val
-
-	return val
-}
-
-func bxc(registers *map[string]int) int {
-	value := (*registers)["B"] ^ (*registers)["C"]
-	(*registers)["B"] = value
-	return value
-}
-
-func jnz(instructionPointer *int, registers *map[string]int, operand int) bool {
-	if (*registers)["A"] == 0 {
-		return false
-	}
-	(*instructionPointer) = operand
-	return true
-}
-
-func out(registers *map[string]int, operand int) int {
-	return getComboOperand(registers, operand) % 8
-}
-
-func bdv(registers *map[string]int, operand int) int {
-	val := (*registers)["A"] / (int(math.Pow(float64(2), float64(getComboOperand(registers, operand)))))
-	(*registers)["B"] = val
-	return val
-}
-
-func cdv(registers *map[string]int, operand int) int {
-	calc := (int(math.Pow(float64(2), float64(getComboOperand(registers, operand)))))
-	val := (*registers)["A"] / calc
-	(*registers)["C"] = val
-	return val
-}
-
-func RunProgram(registers *map[string]int, program []int) []int {
-	var outputs []int
-
-	instructionPointer := 0
-	var opCode int
-	var operand int
-
-	for {
-		if instructionPointer >= len(program)-1 {
-			break
-		}
-
-		opCode = program[instructionPointer]
-		operand = program[instructionPointer+1]
-
-		jumped := false
+const (
+	A = iota
+	B
+	C
+)
 
-		if opCode == 0 {
-			adv(registers, operand)
+// Combo operands 0-3 are literal values, and 4-6 are registers A, B and C (7 is reserved)
+var combo = vm.OperandMode{
+	Decode: func(m *vm.Machine, raw int) int {
+		if raw < 4 {
+			return raw
 		}
-
-		if opCode == 1 {
-			bxl(registers, operand)
+		if raw < 7 {
+			return m.Registers[raw-4]
 		}
-
-		if opCode == 2 {
-			bst(registers, operand)
+		panic(fmt.Sprintf("Invalid combo operand %d", raw))
+	},
+	Format: func(m *vm.Machine, raw int) string {
+		if raw < 4 {
+			return strconv.Itoa(raw)
 		}
-
-		if opCode == 3 {
-			jumped = jnz(&instructionPointer, registers, operand)
-		}
-
-		if opCode == 4 {
-			bxc(registers)
-		}
-
-		if opCode == 5 {
-			outVal := out(registers, operand)
-			outputs = append(outputs, outVal)
-		}
-
-		if opCode == 6 {
-			bdv(registers, operand)
-		}
-
-		if opCode == 7 {
-			cdv(registers, operand)
+		if raw < 7 {
+			return m.RegisterNames[raw-4]
 		}
+		return "invalid"
+	},
+}
 
-		if !jumped {
-			instructionPointer += 2
+// The division instructions use a bit shift (a / 2^n == a >> n)
+var instructions = vm.InstructionSet{
+	0: {Name: "adv", Operands: []vm.OperandMode{combo}, Execute: func(m *vm.Machine, ops []int) {
+		m.Registers[A] = m.Registers[A] >> ops[0]
+	}},
+	1: {Name: "bxl", Operands: []vm.OperandMode{vm.Literal}, Execute: func(m *vm.Machine, ops []int) {
+		m.Registers[B] = m.Registers[B] ^ ops[0]
+	}},
+	2: {Name: "bst", Operands: []vm.OperandMode{combo}, Execute: func(m *vm.Machine, ops []int) {
+		m.Registers[B] = ops[0] % 8
+	}},
+	3: {Name: "jnz", Operands: []vm.OperandMode{vm.Literal}, Execute: func(m *vm.Machine, ops []int) {
+		if m.Registers[A] != 0 {
+			m.IP = ops[0]
 		}
-	}
+	}},
+	4: {Name: "bxc", Operands: []vm.OperandMode{vm.Ignored}, Execute: func(m *vm.Machine, ops []int) {
+		m.Registers[B] = m.Registers[B] ^ m.Registers[C]
+	}},
+	5: {Name: "out", Operands: []vm.OperandMode{combo}, Execute: func(m *vm.Machine, ops []int) {
+		m.Out(ops[0] % 8)
+	}},
+	6: {Name: "bdv", Operands: []vm.OperandMode{combo}, Execute: func(m *vm.Machine, ops []int) {
+		m.Registers[B] = m.Registers[A] >> ops[0]
+	}},
+	7: {Name: "cdv", Operands: []vm.OperandMode{combo}, Execute: func(m *vm.Machine, ops []int) {
+		m.Registers[C] = m.Registers[A] >> ops[0]
+	}},
+}
 
-	return outputs
+func newMachine(program []int) *vm.Machine {
+	return vm.New(instructions, []string{"A", "B", "C"}, program)
 }
 
-func solve(n int, d int, program []int) int {
-	res := []int{math.MaxInt64}
+func RunProgram(registers *map[string]int, program []int) []int {
+	m := newMachine(program)
+	m.Reset((*registers)["A"], (*registers)["B"], (*registers)["C"])
 
-	if d == -1 {
-		return n
+	if err := m.Run(); err != nil {
+		panic(err)
 	}
 
-	// Work backwards through the digits to solve
-
-	// Check each 3 bit value (numbers 0-7) because the program output uses mod 8
-	for i := 0; i < 8; i++ {
-		// Digit changes every 8^d iterations, where d is the index of the digit in the program
-		nn := n + i*int(math.Pow(8, float64(d)))
-
-		registers := make(map[string]int)
-		registers["A"] = nn
-		registers["B"] = 0
-		registers["C"] = 0
-		outputs := RunProgram(&registers, program)
-
-		if len(outputs) != len(program) {
-			continue
-		}
-
-		if outputs[d] == program[d] {
-			// Partial match - continue to next digit
-			res = append(res, solve(nn, d-1, program))
-		}
+	for i, name := range m.RegisterNames {
+		(*registers)[name] = m.Registers[i]
 	}
 
-	return slices.Min(res)
+	return m.Output
 }
 
 func Part1(input string) string {
@@ -251,9 +161,18 @@ func Part1(input string) string {
 }
 
 func Part2(input string) string {
-	_, program := parseInput(input)
+	registers, program := parseInput(input)
+
+	m := newMachine(program)
+	m.Reset(0, registers["B"], registers["C"])
 
-	ans := solve(0, len(program)-1, program)
+	// The program outputs one value per octal digit of A, shifting A right by 3 each loop,
+	// so A can be found a digit at a time from the most significant (the last output)
+	ans, ok := m.SearchBackward(A, 8, program)
+
+	if !ok {
+		panic("Could not find a value for register A")
+	}
 
 	return strconv.Itoa(ans)
 }
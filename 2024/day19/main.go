@@ -8,6 +8,7 @@ import (
 	"runtime"
 	"strings"
 
+	"github.com/jmugliston/aoc/memo"
 	"github.com/jmugliston/aoc/parsing"
 )
 
@@ -47,36 +48,32 @@ func parseInput(input string) ([]string, []string) {
 	return patterns, designs
 }
 
-// Return a count of the number of ways the design can be matched
-func matchCount(design string, patterns []string, cache *map[string]int) int {
-	if len(design) == 0 {
-		return 1
-	}
-
-	if _, ok := (*cache)[design]; ok {
-		return (*cache)[design]
-	}
-
-	count := 0
-	for _, pattern := range patterns {
-		if strings.HasPrefix(design, pattern) {
-			count += matchCount(design[len(pattern):], patterns, cache)
+// Count the number of ways each design can be matched
+func matchCounter(patterns []string) *memo.Cache[string, int] {
+	return memo.NewRecursive(func(matchCount func(string) int, design string) int {
+		if len(design) == 0 {
+			return 1
 		}
-	}
 
-	(*cache)[design] = count
+		count := 0
+		for _, pattern := range patterns {
+			if strings.HasPrefix(design, pattern) {
+				count += matchCount(design[len(pattern):])
+			}
+		}
 
-	return count
+		return count
+	})
 }
 
 func Part1(input string) int {
 	patterns, designs := parseInput(input)
 
-	cache := make(map[string]int)
+	matchCount := matchCounter(patterns)
 
 	count := 0
 	for _, design := range designs {
-		if matchCount(design, patterns, &cache) > 0 {
+		if matchCount.Get(design) > 0 {
 			count++
 		}
 	}
@@ -87,11 +84,11 @@ func Part1(input string) int {
 func Part2(input string) int {
 	patterns, designs := parseInput(input)
 
-	cache := make(map[string]int)
+	matchCount := matchCounter(patterns)
 
 	count := 0
 	for _, design := range designs {
-		count += matchCount(design, patterns, &cache)
+		count += matchCount.Get(design)
 	}
 
 	return count
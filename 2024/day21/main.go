@@ -12,6 +12,7 @@ import (
 	"strings"
 
 	"github.com/jmugliston/aoc/grid"
+	"github.com/jmugliston/aoc/memo"
 	"github.com/jmugliston/aoc/parsing"
 )
 
@@ -187,47 +188,48 @@ func buildSequences(keys []string, index int, prevKey string, currPath []string,
 	return result
 }
 
-// Find the shortest sequence of keys
-func shortestSequence(keys []string, depth int, cache map[string]int, keyMap map[string][][]string) int {
-	if depth == 0 {
-		return len(keys)
-	}
-
-	cacheKey := strings.Join(keys, "") + ":" + strconv.Itoa(depth)
-
-	if cached, ok := cache[cacheKey]; ok {
-		return cached
-	}
-
-	total := 0
+type press struct {
+	// The keys to press, joined together
+	keys  string
+	depth int
+}
 
-	for _, subKey := range strings.SplitAfter(strings.Join(keys, ""), "A") {
-		if subKey == "" {
-			continue
+// Find the shortest sequence of keys
+func shortestSequencer(keyMap map[string][][]string) *memo.Cache[press, int] {
+	return memo.NewRecursive(func(shortestSequence func(press) int, p press) int {
+		if p.depth == 0 {
+			return len(p.keys)
 		}
 
-		sequences := buildSequences(strings.Split(subKey, ""), 0, "A", []string{}, keyMap)
+		total := 0
 
-		min := math.MaxInt64
-		for _, sequence := range sequences {
-			next := shortestSequence(sequence, depth-1, cache, keyMap)
-			if next < min {
-				min = next
+		for _, subKey := range strings.SplitAfter(p.keys, "A") {
+			if subKey == "" {
+				continue
 			}
-		}
 
-		total = total + min
-	}
+			sequences := buildSequences(strings.Split(subKey, ""), 0, "A", []string{}, keyMap)
 
-	cache[cacheKey] = total
+			min := math.MaxInt64
+			for _, sequence := range sequences {
+				next := shortestSequence(press{keys: strings.Join(sequence, ""), depth: p.depth - 1})
+				if next < min {
+					min = next
+				}
+			}
+
+			total = total + min
+		}
 
-	return total
+		return total
+	})
 }
 
 func Part1(input string) int {
 	sequences := parsing.ReadLines(input)
 
 	keyMap := buildKeyMap()
+	shortestSequence := shortestSequencer(keyMap)
 
 	levels := 2
 
@@ -239,7 +241,7 @@ func Part1(input string) int {
 		shortestSeqs := []int{}
 
 		for _, seq := range result {
-			shortestSeqs = append(shortestSeqs, shortestSequence(seq, levels, make(map[string]int), keyMap))
+			shortestSeqs = append(shortestSeqs, shortestSequence.Get(press{keys: strings.Join(seq, ""), depth: levels}))
 		}
 
 		minSeq := slices.Min(shortestSeqs)
@@ -256,6 +258,7 @@ func Part2(input string) int {
 	sequences := parsing.ReadLines(input)
 
 	keyMap := buildKeyMap()
+	shortestSequence := shortestSequencer(keyMap)
 
 	levels := 25
 
@@ -267,7 +270,7 @@ func Part2(input string) int {
 		shortestSeqs := []int{}
 
 		for _, seq := range result {
-			shortestSeqs = append(shortestSeqs, shortestSequence(seq, levels, make(map[string]int), keyMap))
+			shortestSeqs = append(shortestSeqs, shortestSequence.Get(press{keys: strings.Join(seq, ""), depth: levels}))
 		}
 
 		minSeq := slices.Min(shortestSeqs)
@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	md "github.com/JohannesKaufmann/html-to-markdown"
+	"github.com/jmugliston/aoc/viz"
 	"golang.org/x/net/html"
 )
 
@@ -241,6 +242,149 @@ func SolveDay(year string, day string, part string, example bool) string {
 	return answer
 }
 
+// ExportGraph runs the solution for a given year and day in graph mode and saves the graph text to a file.
+// The day must support the --graph flag, printing its parsed input as DOT or Mermaid text.
+//
+// Parameters:
+//   - year: The year of the Advent of Code puzzle.
+//   - day: The day of the Advent of Code puzzle.
+//   - format: The graph format to export ("dot" or "mermaid").
+//   - example: A boolean indicating whether to use the example input.
+//   - output: The file to write the graph to. Defaults to graph.dot or graph.mmd in the day folder.
+//
+// Example:
+//
+//	ExportGraph("2023", "20", "dot", false, "")
+func ExportGraph(year string, day string, format string, example bool, output string) {
+	logger.Info("Exporting graph", "year", year, "day", day, "format", format)
+
+	path := filepath.Join(".", year, "day"+getPaddedDay(day))
+
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		logger.Error("Selected day does not exist")
+		os.Exit(1)
+	}
+
+	cmdArgs := []string{"run", fmt.Sprintf("%s/main.go", path), "--graph", format}
+	if example {
+		cmdArgs = append(cmdArgs, "--example")
+	}
+	out, err := exec.Command("go", cmdArgs...).Output()
+
+	if err != nil {
+		logger.Error("Selected day does not support graph export", "err", err)
+		os.Exit(1)
+	}
+
+	if output == "" {
+		extension := map[string]string{"dot": "dot", "mermaid": "mmd"}[format]
+		output = filepath.Join(path, "graph."+extension)
+	}
+
+	saveStringToFile(string(out), output)
+
+	logger.Info("Saved graph", "path", output)
+}
+
+// loadFrames runs the solution for a given year, day, and part in visualisation mode and reads its frames.
+// If the day does not support the --visualize flag, it logs an error and exits with code 1.
+func loadFrames(year string, day string, part string, example bool) []viz.Frame {
+	path := filepath.Join(".", year, "day"+getPaddedDay(day))
+
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		logger.Error("Selected day does not exist")
+		os.Exit(1)
+	}
+
+	cmdArgs := []string{"run", fmt.Sprintf("%s/main.go", path), "--part", part, "--visualize"}
+	if example {
+		cmdArgs = append(cmdArgs, "--example")
+	}
+	out, err := exec.Command("go", cmdArgs...).Output()
+
+	if err != nil {
+		logger.Error("Selected day does not support visualisation", "err", err)
+		os.Exit(1)
+	}
+
+	frames, err := viz.Decode(bytes.NewReader(out))
+
+	if err != nil || len(frames) == 0 {
+		logger.Error("Selected day does not support visualisation", "err", err)
+		os.Exit(1)
+	}
+
+	return frames
+}
+
+// VisualizeDay runs the solution for a given year, day, and part in visualisation mode and plays its frames in the terminal.
+// The day must support the --visualize flag, printing its frames with a viz.Recorder.
+//
+// Parameters:
+//   - year: The year of the Advent of Code puzzle.
+//   - day: The day of the Advent of Code puzzle.
+//   - part: The part of the Advent of Code puzzle (1 or 2).
+//   - example: A boolean indicating whether to use the example input.
+//   - speed: The number of frames to play per second.
+//
+// Example:
+//
+//	VisualizeDay("2024", "14", "2", false, 10)
+func VisualizeDay(year string, day string, part string, example bool, speed int) {
+	logger.Info("Visualising", "year", year, "day", day, "part", part)
+
+	frames := loadFrames(year, day, part, example)
+
+	keys, restore := viz.Keys(os.Stdin)
+	defer restore()
+
+	player := viz.NewPlayer(frames, os.Stdout)
+	player.Speed = speed
+	player.Play(keys)
+}
+
+// ExportFrames runs the solution for a given year, day, and part in visualisation mode and saves its frames as an image.
+// A .gif output is an animation of every frame, and a .png output is the last frame.
+//
+// Parameters:
+//   - year: The year of the Advent of Code puzzle.
+//   - day: The day of the Advent of Code puzzle.
+//   - part: The part of the Advent of Code puzzle (1 or 2).
+//   - example: A boolean indicating whether to use the example input.
+//   - output: The image file to write (.png or .gif).
+//   - options: The palette, scale and frame delay to draw with.
+//
+// Example:
+//
+//	ExportFrames("2023", "10", "2", false, "loop.png", viz.DefaultImageOptions)
+func ExportFrames(year string, day string, part string, example bool, output string, options viz.ImageOptions) {
+	logger.Info("Exporting frames", "year", year, "day", day, "part", part, "output", output)
+
+	frames := loadFrames(year, day, part, example)
+
+	file, err := os.Create(output)
+
+	if err != nil {
+		logger.Error("Could not create the output file", "err", err)
+		os.Exit(1)
+	}
+
+	defer file.Close()
+
+	if strings.EqualFold(filepath.Ext(output), ".gif") {
+		err = viz.WriteGIF(file, frames, options)
+	} else {
+		err = viz.WritePNG(file, frames[len(frames)-1], options)
+	}
+
+	if err != nil {
+		logger.Error("Could not export the frames", "err", err)
+		os.Exit(1)
+	}
+
+	logger.Info("Saved frames", "path", output, "frames", len(frames))
+}
+
 // SubmitAnswer submits the answer for a given year, day, and part to the Advent of Code API.
 // It posts the answer to the API and prints the response message.
 //
@@ -3,9 +3,12 @@ package cli
 import (
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/charmbracelet/log"
+	"github.com/jmugliston/aoc/viz"
 	"github.com/spf13/cobra"
 )
 
@@ -143,6 +146,44 @@ var solveCmd = &cobra.Command{
 			os.Exit(1)
 		}
 
+		visualize, err := cmd.Flags().GetBool("visualize")
+
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		export, err := cmd.Flags().GetString("export")
+
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		if export != "" {
+			options, err := validateImageFlags(cmd)
+
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+
+			ExportFrames(fmt.Sprint(year), fmt.Sprint(day), fmt.Sprint(part), example, export, options)
+			return
+		}
+
+		if visualize {
+			speed, err := cmd.Flags().GetInt("speed")
+
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+
+			VisualizeDay(fmt.Sprint(year), fmt.Sprint(day), fmt.Sprint(part), example, speed)
+			return
+		}
+
 		SolveDay(fmt.Sprint(year), fmt.Sprint(day), fmt.Sprint(part), example)
 	},
 }
@@ -179,6 +220,52 @@ var submitCmd = &cobra.Command{
 	},
 }
 
+var graphCmd = &cobra.Command{
+	Use:     "graph",
+	Short:   "Export the parsed graph for a specific day",
+	Example: "aoc graph --day 20 --format mermaid",
+	Run: func(cmd *cobra.Command, args []string) {
+		setLogLevel(cmd)
+
+		year, err := validateYearFlag(cmd)
+
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		day, err := validateDayFlag(cmd)
+
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		format, err := validateFormatFlag(cmd)
+
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		example, err := cmd.Flags().GetBool("example")
+
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		output, err := cmd.Flags().GetString("output")
+
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		ExportGraph(fmt.Sprint(year), fmt.Sprint(day), format, example, output)
+	},
+}
+
 func validateYearFlag(cmd *cobra.Command) (int, error) {
 	year, err := cmd.Flags().GetInt("year")
 	if err != nil {
@@ -221,6 +308,63 @@ func validatePartFlag(cmd *cobra.Command) (int, error) {
 	return part, nil
 }
 
+func validateFormatFlag(cmd *cobra.Command) (string, error) {
+	format, err := cmd.Flags().GetString("format")
+	if err != nil {
+		return "", err
+	}
+
+	if format != "dot" && format != "mermaid" {
+		return "", fmt.Errorf("error: The 'format' flag must be either dot or mermaid")
+	}
+
+	return format, nil
+}
+
+func validateImageFlags(cmd *cobra.Command) (viz.ImageOptions, error) {
+	options := viz.DefaultImageOptions
+
+	export, err := cmd.Flags().GetString("export")
+	if err != nil {
+		return options, err
+	}
+
+	extension := strings.ToLower(filepath.Ext(export))
+	if extension != ".png" && extension != ".gif" {
+		return options, fmt.Errorf("error: The 'export' flag must be a .png or .gif file")
+	}
+
+	options.Scale, err = cmd.Flags().GetInt("scale")
+	if err != nil {
+		return options, err
+	}
+
+	if options.Scale < 1 {
+		return options, fmt.Errorf("error: The 'scale' flag must be at least 1")
+	}
+
+	speed, err := cmd.Flags().GetInt("speed")
+	if err != nil {
+		return options, err
+	}
+
+	options.Delay = max(100/max(speed, 1), 1)
+
+	palette, err := cmd.Flags().GetString("palette")
+	if err != nil {
+		return options, err
+	}
+
+	if palette != "" {
+		options.Palette, err = viz.ParsePalette(palette)
+		if err != nil {
+			return options, fmt.Errorf("error: %w", err)
+		}
+	}
+
+	return options, nil
+}
+
 func init() {
 
 	currentYear, currentMonth, currentDay := time.Now().Date()
@@ -245,6 +389,11 @@ func init() {
 	solveCmd.Flags().IntP("day", "d", defaultDay, "puzzle day")
 	solveCmd.Flags().IntP("part", "p", 1, "puzzle part")
 	solveCmd.Flags().BoolP("example", "e", false, "use example input")
+	solveCmd.Flags().Bool("visualize", false, "play the solution's frames in the terminal")
+	solveCmd.Flags().Int("speed", 10, "frames per second when visualising")
+	solveCmd.Flags().String("export", "", "save the frames to an image instead of playing them (.png for the last frame or .gif)")
+	solveCmd.Flags().Int("scale", viz.DefaultImageOptions.Scale, "pixels per cell when exporting")
+	solveCmd.Flags().String("palette", "", "cell colours when exporting, e.g. '#=00cc00,O=ffffff' (default colours #, I and pipes)")
 	solveCmd.MarkFlagRequired("day")
 
 	submitCmd.Flags().IntP("year", "y", defaultYear, "puzzle year")
@@ -255,10 +404,18 @@ func init() {
 	downloadCmd.Flags().IntP("year", "y", defaultYear, "puzzle year")
 	downloadCmd.Flags().IntP("day", "d", 0, "puzzle day")
 
+	graphCmd.Flags().IntP("year", "y", defaultYear, "puzzle year")
+	graphCmd.Flags().IntP("day", "d", defaultDay, "puzzle day")
+	graphCmd.Flags().StringP("format", "f", "dot", "graph format (dot or mermaid)")
+	graphCmd.Flags().StringP("output", "o", "", "output file (default graph.dot or graph.mmd in the day folder)")
+	graphCmd.Flags().BoolP("example", "e", false, "use example input")
+	graphCmd.MarkFlagRequired("day")
+
 	rootCmd.AddCommand(initCmd)
 	rootCmd.AddCommand(downloadCmd)
 	rootCmd.AddCommand(solveCmd)
 	rootCmd.AddCommand(submitCmd)
+	rootCmd.AddCommand(graphCmd)
 }
 
 func Execute() {
@@ -1,6 +1,6 @@
 module github.com/jmugliston/aoc
 
-go 1.22.2
+go 1.23
 
 replace github.com/jmugliston/aoc/cli => ./cli
 
@@ -16,8 +16,30 @@ replace github.com/jmugliston/aoc/xyz => ./utils/geometry/xyz
 
 replace github.com/jmugliston/aoc/bigxyz => ./utils/geometry/bigxyz
 
+replace github.com/jmugliston/aoc/hex => ./utils/geometry/hex
+
 replace github.com/jmugliston/aoc/graph => ./utils/graph
 
+replace github.com/jmugliston/aoc/cycle => ./utils/cycle
+
+replace github.com/jmugliston/aoc/interval => ./utils/interval
+
+replace github.com/jmugliston/aoc/memo => ./utils/memo
+
+replace github.com/jmugliston/aoc/linalg => ./utils/linalg
+
+replace github.com/jmugliston/aoc/combin => ./utils/combin
+
+replace github.com/jmugliston/aoc/vm => ./utils/vm
+
+replace github.com/jmugliston/aoc/circuit => ./utils/circuit
+
+replace github.com/jmugliston/aoc/pulse => ./utils/pulse
+
+replace github.com/jmugliston/aoc/automaton => ./utils/automaton
+
+replace github.com/jmugliston/aoc/viz => ./utils/viz
+
 require (
 	github.com/jmugliston/aoc/grid v0.0.0-00010101000000-000000000000
 	github.com/jmugliston/aoc/parsing v0.0.0-00010101000000-000000000000
@@ -28,15 +50,26 @@ require (
 require (
 	github.com/JohannesKaufmann/html-to-markdown v1.6.0
 	github.com/charmbracelet/log v0.4.0
+	github.com/jmugliston/aoc/automaton v0.0.0-00010101000000-000000000000
 	github.com/jmugliston/aoc/bigInt v0.0.0-00010101000000-000000000000
 	github.com/jmugliston/aoc/bigxyz v0.0.0-00010101000000-000000000000
+	github.com/jmugliston/aoc/circuit v0.0.0-00010101000000-000000000000
+	github.com/jmugliston/aoc/combin v0.0.0-00010101000000-000000000000
+	github.com/jmugliston/aoc/cycle v0.0.0-00010101000000-000000000000
 	github.com/jmugliston/aoc/graph v0.0.0-00010101000000-000000000000
+	github.com/jmugliston/aoc/hex v0.0.0-00010101000000-000000000000
+	github.com/jmugliston/aoc/interval v0.0.0-00010101000000-000000000000
+	github.com/jmugliston/aoc/linalg v0.0.0-00010101000000-000000000000
+	github.com/jmugliston/aoc/memo v0.0.0-00010101000000-000000000000
+	github.com/jmugliston/aoc/pulse v0.0.0-00010101000000-000000000000
+	github.com/jmugliston/aoc/viz v0.0.0-00010101000000-000000000000
+	github.com/jmugliston/aoc/vm v0.0.0-00010101000000-000000000000
 	github.com/jmugliston/aoc/xyz v0.0.0-00010101000000-000000000000
 	github.com/joho/godotenv v1.5.1
 	github.com/manifoldco/promptui v0.9.0
 	github.com/spf13/cobra v1.8.1
+	golang.org/x/exp v0.0.0-20231110203233-9a3e6036ecaa
 	golang.org/x/net v0.26.0
-	gonum.org/v1/gonum v0.15.0
 )
 
 require (
@@ -54,6 +87,5 @@ require (
 	github.com/muesli/termenv v0.15.2 // indirect
 	github.com/rivo/uniseg v0.4.7 // indirect
 	github.com/spf13/pflag v1.0.5 // indirect
-	golang.org/x/exp v0.0.0-20231110203233-9a3e6036ecaa // indirect
 	golang.org/x/sys v0.21.0 // indirect
 )
@@ -0,0 +1,97 @@
+package automaton
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/jmugliston/aoc/grid"
+)
+
+// Cellular automata - https://en.wikipedia.org/wiki/Cellular_automaton
+// Every cell is updated at once from the previous generation, so rules never see half-updated grids
+
+// A read-only view of the current generation
+type View[T comparable] interface {
+	Get(p grid.Point) T
+}
+
+// The next value of a cell, from its current value and the rest of the current generation
+// Rules may run in parallel, so they should only read from the view
+type Rule[T comparable] func(p grid.Point, current T, world View[T]) T
+
+// A grid that can be stepped through generations (see Dense and Sparse)
+type Engine[T comparable] interface {
+	View[T]
+	Step(rule Rule[T])
+	Generation() int
+	// A hash of the current cells, for spotting repeated states
+	Hash() uint64
+	// The cells as text, one line per row (a nil format uses fmt.Sprint)
+	Render(format func(T) string) string
+}
+
+// Number of neighbours of a point with a value
+func CountNeighbours[T comparable](world View[T], p grid.Point, value T, connectivity grid.Connectivity) int {
+	directions := []grid.Direction{grid.North, grid.East, grid.South, grid.West}
+	if connectivity == grid.EightConnected {
+		directions = grid.Directions[:]
+	}
+
+	count := 0
+	for _, d := range directions {
+		if world.Get(p.Add(d.Vector())) == value {
+			count++
+		}
+	}
+	return count
+}
+
+// Step a number of generations, calling observe after each one (return false to stop early)
+func Run[T comparable](e Engine[T], rule Rule[T], generations int, observe func(e Engine[T]) bool) {
+	for i := 0; i < generations; i++ {
+		e.Step(rule)
+		if observe != nil && !observe(e) {
+			return
+		}
+	}
+}
+
+// Step until the cells repeat an earlier generation, up to a limit
+// Returns the first generation of the cycle and its length
+func FindCycle[T comparable](e Engine[T], rule Rule[T], limit int) (int, int, bool) {
+	seen := map[uint64]int{e.Hash(): e.Generation()}
+	for i := 0; i < limit; i++ {
+		e.Step(rule)
+		hash := e.Hash()
+		if start, ok := seen[hash]; ok {
+			return start, e.Generation() - start, true
+		}
+		seen[hash] = e.Generation()
+	}
+	return 0, 0, false
+}
+
+// Step until a generation is the same as the one before it, up to a limit
+// Returns whether it settled
+func RunUntilStable[T comparable](e Engine[T], rule Rule[T], limit int) bool {
+	previous := e.Hash()
+	for i := 0; i < limit; i++ {
+		e.Step(rule)
+		hash := e.Hash()
+		if hash == previous {
+			return true
+		}
+		previous = hash
+	}
+	return false
+}
+
+// Write the current generation to a text file in dir, named by the generation (e.g. 0042.txt)
+func SaveSnapshot[T comparable](e Engine[T], dir string, format func(T) string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	path := filepath.Join(dir, fmt.Sprintf("%04d.txt", e.Generation()))
+	return os.WriteFile(path, []byte(e.Render(format)), 0644)
+}
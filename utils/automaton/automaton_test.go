@@ -0,0 +1,141 @@
+package automaton
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jmugliston/aoc/grid"
+)
+
+func readExample(path string) string {
+	input, err := os.ReadFile(path)
+	if err != nil {
+		panic("Couldn't find the example file!")
+	}
+	return string(input)
+}
+
+// Conway's game of life - https://en.wikipedia.org/wiki/Conway%27s_Game_of_Life
+func life(p grid.Point, current bool, world View[bool]) bool {
+	n := CountNeighbours(world, p, true, grid.EightConnected)
+	return n == 3 || (current && n == 2)
+}
+
+// Rounded rocks roll north one step at a time (2023 day 14)
+func rollNorth(p grid.Point, current string, world View[string]) string {
+	switch {
+	case current == "." && world.Get(p.Add(grid.South.Vector())) == "O":
+		return "O"
+	case current == "O" && world.Get(p.Add(grid.North.Vector())) == ".":
+		return "."
+	}
+	return current
+}
+
+func TestDenseBlinker(t *testing.T) {
+	d := NewDense([][]bool{
+		{false, false, false},
+		{true, true, true},
+		{false, false, false},
+	}, false)
+
+	d.Step(life)
+
+	expected := ".#.\n.#.\n.#.\n"
+	result := d.Render(func(alive bool) string {
+		if alive {
+			return "#"
+		}
+		return "."
+	})
+
+	if result != expected {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+
+	start, length, ok := FindCycle(d, life, 10)
+	if !ok || start != 1 || length != 2 {
+		t.Errorf("Expected cycle 1 2, got %v %v %v", start, length, ok)
+	}
+}
+
+func TestSparseGlider(t *testing.T) {
+	cells := grid.NewSparseGrid(false)
+	for _, p := range []grid.Point{{X: 1, Y: 0}, {X: 2, Y: 1}, {X: 0, Y: 2}, {X: 1, Y: 2}, {X: 2, Y: 2}} {
+		cells.SetPoint(p, true)
+	}
+	s := NewSparse(cells)
+
+	Run(s, life, 4, nil)
+
+	// After 4 generations the glider has moved one down and one right
+	for _, p := range []grid.Point{{X: 2, Y: 1}, {X: 3, Y: 2}, {X: 1, Y: 3}, {X: 2, Y: 3}, {X: 3, Y: 3}} {
+		if !s.Get(p) {
+			t.Errorf("Expected %v to be alive", p)
+		}
+	}
+	if s.Cells().Len() != 5 {
+		t.Errorf("Expected %v, got %v", 5, s.Cells().Len())
+	}
+
+	// The glider never returns to the same cells, so it isn't a cycle (or stable)
+	if _, _, ok := FindCycle(s, life, 10); ok {
+		t.Errorf("Expected no cycle for a moving glider")
+	}
+	if RunUntilStable(s, life, 10) {
+		t.Errorf("Expected a moving glider not to be stable")
+	}
+
+	start, length, offset, ok := FindShapeCycle(s, life, 10)
+	if !ok || start != 24 || length != 4 || offset != (grid.Point{X: 1, Y: 1}) {
+		t.Errorf("Expected shape cycle 24 4 {1 1}, got %v %v %v %v", start, length, offset, ok)
+	}
+}
+
+func TestRollNorth(t *testing.T) {
+	d := NewDense(grid.Parse(readExample("../../2023/day14/input/example.txt")), "#")
+	d.Workers = 3
+
+	if !RunUntilStable(d, rollNorth, 100) {
+		t.Fatalf("Expected the rocks to settle")
+	}
+
+	load := 0
+	for y, row := range d.Grid() {
+		for _, cell := range row {
+			if cell == "O" {
+				load += d.Height - y
+			}
+		}
+	}
+
+	expected := 136
+	if load != expected {
+		t.Errorf("Expected %v, got %v", expected, load)
+	}
+}
+
+func TestSaveSnapshot(t *testing.T) {
+	d := NewDense([][]string{{"a", "b"}}, ".")
+	dir := t.TempDir()
+
+	Run(d, func(p grid.Point, current string, world View[string]) string {
+		return world.Get(p.Add(grid.East.Vector()))
+	}, 1, func(e Engine[string]) bool {
+		if err := SaveSnapshot(e, dir, nil); err != nil {
+			t.Fatal(err)
+		}
+		return true
+	})
+
+	result, err := os.ReadFile(filepath.Join(dir, "0001.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "b.\n"
+	if string(result) != expected {
+		t.Errorf("Expected %v, got %v", expected, string(result))
+	}
+}
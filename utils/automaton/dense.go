@@ -0,0 +1,119 @@
+package automaton
+
+import (
+	"fmt"
+	"hash/fnv"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/jmugliston/aoc/grid"
+)
+
+// A fixed size grid, with two buffers so each generation is written into the spare one
+type Dense[T comparable] struct {
+	current [][]T
+	next    [][]T
+	Width   int
+	Height  int
+	// The value of every point off the grid
+	Outside T
+	// Number of bands of rows updated in parallel
+	Workers    int
+	generation int
+}
+
+// An engine starting from a copy of a grid
+func NewDense[G ~[][]T, T comparable](g G, outside T) *Dense[T] {
+	d := &Dense[T]{Height: len(g), Outside: outside, Workers: runtime.NumCPU()}
+	if d.Height > 0 {
+		d.Width = len(g[0])
+	}
+
+	d.current = make([][]T, d.Height)
+	d.next = make([][]T, d.Height)
+	for y := range g {
+		d.current[y] = append([]T{}, g[y]...)
+		d.next[y] = make([]T, d.Width)
+	}
+
+	return d
+}
+
+func (d *Dense[T]) Get(p grid.Point) T {
+	if p.X < 0 || p.Y < 0 || p.X >= d.Width || p.Y >= d.Height {
+		return d.Outside
+	}
+	return d.current[p.Y][p.X]
+}
+
+// Change a cell in the current generation
+func (d *Dense[T]) Set(p grid.Point, value T) {
+	d.current[p.Y][p.X] = value
+}
+
+func (d *Dense[T]) Generation() int {
+	return d.generation
+}
+
+func (d *Dense[T]) stepRows(rule Rule[T], from int, to int) {
+	for y := from; y < to; y++ {
+		for x := 0; x < d.Width; x++ {
+			d.next[y][x] = rule(grid.Point{X: x, Y: y}, d.current[y][x], d)
+		}
+	}
+}
+
+func (d *Dense[T]) Step(rule Rule[T]) {
+	workers := max(1, min(d.Workers, d.Height))
+	band := (d.Height + workers - 1) / workers
+
+	if workers == 1 {
+		d.stepRows(rule, 0, d.Height)
+	} else {
+		var wg sync.WaitGroup
+		for from := 0; from < d.Height; from += band {
+			wg.Add(1)
+			go func(from int) {
+				defer wg.Done()
+				d.stepRows(rule, from, min(from+band, d.Height))
+			}(from)
+		}
+		wg.Wait()
+	}
+
+	d.current, d.next = d.next, d.current
+	d.generation++
+}
+
+// A copy of the current generation
+func (d *Dense[T]) Grid() [][]T {
+	g := make([][]T, d.Height)
+	for y := range d.current {
+		g[y] = append([]T{}, d.current[y]...)
+	}
+	return g
+}
+
+func (d *Dense[T]) Hash() uint64 {
+	h := fnv.New64a()
+	for _, row := range d.current {
+		fmt.Fprintln(h, row)
+	}
+	return h.Sum64()
+}
+
+func (d *Dense[T]) Render(format func(T) string) string {
+	if format == nil {
+		format = func(value T) string { return fmt.Sprint(value) }
+	}
+
+	var output strings.Builder
+	for _, row := range d.current {
+		for _, value := range row {
+			output.WriteString(format(value))
+		}
+		output.WriteString("\n")
+	}
+	return output.String()
+}
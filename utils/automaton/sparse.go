@@ -0,0 +1,132 @@
+package automaton
+
+import (
+	"fmt"
+	"hash/fnv"
+	"runtime"
+	"sync"
+
+	"github.com/jmugliston/aoc/grid"
+)
+
+// An unbounded grid where most cells have the default value
+// Only cells that are set, and their neighbours, are passed to the rule
+type Sparse[T comparable] struct {
+	cells *grid.SparseGrid[T]
+	// Number of goroutines the candidate cells are split between
+	Workers    int
+	generation int
+}
+
+func NewSparse[T comparable](cells *grid.SparseGrid[T]) *Sparse[T] {
+	return &Sparse[T]{cells: cells, Workers: runtime.NumCPU()}
+}
+
+func (s *Sparse[T]) Get(p grid.Point) T {
+	return s.cells.GetPoint(p)
+}
+
+func (s *Sparse[T]) Set(p grid.Point, value T) {
+	s.cells.SetPoint(p, value)
+}
+
+func (s *Sparse[T]) Generation() int {
+	return s.generation
+}
+
+// The current generation (changed by later steps)
+func (s *Sparse[T]) Cells() *grid.SparseGrid[T] {
+	return s.cells
+}
+
+func (s *Sparse[T]) Step(rule Rule[T]) {
+	// Cells that could change - anything set, and everything next to it
+	seen := map[grid.Point]bool{}
+	candidates := []grid.Point{}
+	for _, p := range s.cells.Points() {
+		for _, q := range append([]grid.Point{p}, neighbours(p)...) {
+			if !seen[q] {
+				seen[q] = true
+				candidates = append(candidates, q)
+			}
+		}
+	}
+
+	values := make([]T, len(candidates))
+	workers := max(1, min(s.Workers, len(candidates)))
+	band := (len(candidates) + workers - 1) / max(workers, 1)
+
+	var wg sync.WaitGroup
+	for from := 0; from < len(candidates); from += band {
+		wg.Add(1)
+		go func(from int) {
+			defer wg.Done()
+			for i := from; i < min(from+band, len(candidates)); i++ {
+				values[i] = rule(candidates[i], s.cells.GetPoint(candidates[i]), s)
+			}
+		}(from)
+	}
+	wg.Wait()
+
+	next := grid.NewSparseGrid(s.cells.Default)
+	for i, p := range candidates {
+		next.SetPoint(p, values[i])
+	}
+
+	s.cells = next
+	s.generation++
+}
+
+func neighbours(p grid.Point) []grid.Point {
+	points := make([]grid.Point, 0, len(grid.Directions))
+	for _, d := range grid.Directions {
+		points = append(points, p.Add(d.Vector()))
+	}
+	return points
+}
+
+func (s *Sparse[T]) Hash() uint64 {
+	h := fnv.New64a()
+	for _, p := range s.cells.Points() {
+		fmt.Fprintln(h, p, s.cells.GetPoint(p))
+	}
+	return h.Sum64()
+}
+
+// A hash of the pattern that ignores where it is (so a moving pattern hashes the same each time its shape repeats)
+func (s *Sparse[T]) ShapeHash() uint64 {
+	h := fnv.New64a()
+	topLeft, _ := s.cells.Bounds()
+	for _, p := range s.cells.Points() {
+		fmt.Fprintln(h, p.Sub(topLeft), s.cells.GetPoint(p))
+	}
+	return h.Sum64()
+}
+
+// Step until the pattern repeats the shape of an earlier generation, wherever it is, up to a limit
+// Returns the first generation of the cycle, its length and how far the pattern moves each cycle
+func FindShapeCycle[T comparable](s *Sparse[T], rule Rule[T], limit int) (int, int, grid.Point, bool) {
+	type seen struct {
+		generation int
+		topLeft    grid.Point
+	}
+
+	topLeft, _ := s.cells.Bounds()
+	shapes := map[uint64]seen{s.ShapeHash(): {s.Generation(), topLeft}}
+	for i := 0; i < limit; i++ {
+		s.Step(rule)
+		hash := s.ShapeHash()
+		topLeft, _ := s.cells.Bounds()
+		if first, ok := shapes[hash]; ok {
+			return first.generation, s.Generation() - first.generation, topLeft.Sub(first.topLeft), true
+		}
+		shapes[hash] = seen{s.Generation(), topLeft}
+	}
+	return 0, 0, grid.Point{}, false
+}
+
+// Render the bounding box of the cells that are set
+func (s *Sparse[T]) Render(format func(T) string) string {
+	topLeft, bottomRight := s.cells.Bounds()
+	return s.cells.Render(topLeft, bottomRight, format)
+}
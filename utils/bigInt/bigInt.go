@@ -1,19 +0,0 @@
-package bigInt
-
-import "math/big"
-
-func Mul(x, y *big.Int) *big.Int {
-	return big.NewInt(0).Mul(x, y)
-}
-
-func Sub(x, y *big.Int) *big.Int {
-	return big.NewInt(0).Sub(x, y)
-}
-
-func Add(x, y *big.Int) *big.Int {
-	return big.NewInt(0).Add(x, y)
-}
-
-func Div(x, y *big.Int) *big.Int {
-	return big.NewInt(0).Div(x, y)
-}
@@ -0,0 +1,133 @@
+package bigInt
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// An immutable big integer - every operation returns a new value, so they can be chained
+//
+//	bigInt.New(3).Mul(x).Add(y).Mod(m)
+//
+// The zero value is 0
+type Int struct {
+	v *big.Int
+}
+
+var zero = big.NewInt(0)
+
+func New(n int) Int {
+	return Int{v: big.NewInt(int64(n))}
+}
+
+// A copy of a big.Int
+func FromBig(n *big.Int) Int {
+	return Int{v: new(big.Int).Set(n)}
+}
+
+// Parse a base 10 integer, with an optional sign
+func Parse(s string) (Int, error) {
+	n, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return Int{}, fmt.Errorf("Invalid integer %q", s)
+	}
+	return Int{v: n}, nil
+}
+
+func MustParse(s string) Int {
+	n, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (a Int) value() *big.Int {
+	if a.v == nil {
+		return zero
+	}
+	return a.v
+}
+
+// A copy as a big.Int
+func (a Int) Big() *big.Int {
+	return new(big.Int).Set(a.value())
+}
+
+func (a Int) Add(b Int) Int {
+	return Int{v: new(big.Int).Add(a.value(), b.value())}
+}
+
+func (a Int) Sub(b Int) Int {
+	return Int{v: new(big.Int).Sub(a.value(), b.value())}
+}
+
+func (a Int) Mul(b Int) Int {
+	return Int{v: new(big.Int).Mul(a.value(), b.value())}
+}
+
+// Euclidean division (rounds down for a positive divisor)
+func (a Int) Div(b Int) Int {
+	return Int{v: new(big.Int).Div(a.value(), b.value())}
+}
+
+// Euclidean modulus - always between 0 and |b| - 1
+func (a Int) Mod(b Int) Int {
+	return Int{v: new(big.Int).Mod(a.value(), b.value())}
+}
+
+func (a Int) Neg() Int {
+	return Int{v: new(big.Int).Neg(a.value())}
+}
+
+func (a Int) Abs() Int {
+	return Int{v: new(big.Int).Abs(a.value())}
+}
+
+// a to the power of n (n >= 0)
+func (a Int) Pow(n int) Int {
+	return Int{v: new(big.Int).Exp(a.value(), big.NewInt(int64(n)), nil)}
+}
+
+// The square root, rounded down - panics for negative numbers
+func (a Int) Sqrt() Int {
+	return Int{v: new(big.Int).Sqrt(a.value())}
+}
+
+// -1, 0 or 1 for a < b, a == b and a > b
+func (a Int) Cmp(b Int) int {
+	return a.value().Cmp(b.value())
+}
+
+func (a Int) Equal(b Int) bool {
+	return a.Cmp(b) == 0
+}
+
+// -1, 0 or 1 for negative, zero and positive numbers
+func (a Int) Sign() int {
+	return a.value().Sign()
+}
+
+func (a Int) IsZero() bool {
+	return a.Sign() == 0
+}
+
+// Convert to an int, with an error if it doesn't fit
+func (a Int) ToInt() (int, error) {
+	if !a.value().IsInt64() || int64(int(a.value().Int64())) != a.value().Int64() {
+		return 0, fmt.Errorf("%s overflows an int", a)
+	}
+	return int(a.value().Int64()), nil
+}
+
+func (a Int) MustInt() int {
+	n, err := a.ToInt()
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (a Int) String() string {
+	return a.value().String()
+}
@@ -0,0 +1,55 @@
+package bigInt
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestChaining(t *testing.T) {
+	x := New(7)
+
+	result := x.Mul(New(6)).Sub(New(50)).Abs().Pow(3).Add(New(1))
+
+	if !result.Equal(New(513)) {
+		t.Errorf("Expected %v, got %v", 513, result)
+	}
+
+	// The original values are never changed
+	if !x.Equal(New(7)) {
+		t.Errorf("Expected %v, got %v", 7, x)
+	}
+
+	if New(-7).Mod(New(3)).MustInt() != 2 || New(-7).Div(New(3)).MustInt() != -3 {
+		t.Errorf("Expected euclidean division, got %v and %v", New(-7).Div(New(3)), New(-7).Mod(New(3)))
+	}
+
+	var unset Int
+
+	if !unset.IsZero() || !unset.Add(New(1)).Equal(New(1)) {
+		t.Errorf("Expected the zero value to be 0")
+	}
+}
+
+func TestParseAndConvert(t *testing.T) {
+	large := MustParse("-123456789012345678901234567890")
+
+	if large.Sign() != -1 || large.Neg().Cmp(New(0)) != 1 {
+		t.Errorf("Expected %v to be negative", large)
+	}
+
+	if _, err := large.ToInt(); err == nil {
+		t.Errorf("Expected %v to overflow an int", large)
+	}
+
+	if large.Mul(large).Sqrt().String() != "123456789012345678901234567890" {
+		t.Errorf("Expected %v, got %v", "123456789012345678901234567890", large.Mul(large).Sqrt())
+	}
+
+	if _, err := Parse("12a"); err == nil {
+		t.Errorf("Expected an error for an invalid number")
+	}
+
+	if fmt.Sprint(New(42)) != "42" {
+		t.Errorf("Expected %v, got %v", "42", fmt.Sprint(New(42)))
+	}
+}
@@ -0,0 +1,71 @@
+package circuit
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// A gate that doesn't fit the pattern of a ripple-carry adder
+type Violation struct {
+	Gate   Gate
+	Reason string
+}
+
+func (v Violation) String() string {
+	return fmt.Sprintf("%s: %s", v.Gate, v.Reason)
+}
+
+// Check the circuit is built like a ripple-carry adder (z = x + y), where each bit is
+// a full adder - https://en.wikipedia.org/wiki/Adder_(electronics)#Ripple-carry_adder
+//
+//	s = x XOR y,  z = s XOR carry in
+//	carry out = (x AND y) OR (s AND carry in)
+//
+// Bit 0 is a half adder (z00 = x00 XOR y00, carry = x00 AND y00) and the last z is the final carry
+func (c *Circuit) VerifyAdder(x string, y string, z string) []Violation {
+	outputs := c.Wires(z)
+	if len(outputs) == 0 {
+		return []Violation{}
+	}
+	lastOutput := outputs[len(outputs)-1]
+	firstX, firstY := x+"00", y+"00"
+
+	isInput := func(wire string) bool {
+		return strings.HasPrefix(wire, x) || strings.HasPrefix(wire, y)
+	}
+
+	violations := []Violation{}
+	for _, g := range c.Gates {
+		reason := ""
+		switch {
+		case g.Output == lastOutput && g.Op != "OR" && len(outputs) > 2:
+			reason = "the final carry should come from an OR gate"
+		case strings.HasPrefix(g.Output, z) && g.Output != lastOutput && g.Op != "XOR":
+			reason = "outputs should come from an XOR gate"
+		case g.Op == "XOR" && !isInput(g.A) && !isInput(g.B) && !strings.HasPrefix(g.Output, z):
+			reason = "an XOR of a carry should be an output"
+		case g.Op == "XOR" && slices.ContainsFunc(c.Consumers(g.Output), func(next Gate) bool { return next.Op == "OR" }):
+			reason = "an XOR should not feed the carry OR"
+		case g.Op == "AND" && !g.HasInput(firstX) && !g.HasInput(firstY) &&
+			slices.ContainsFunc(c.Consumers(g.Output), func(next Gate) bool { return next.Op != "OR" }):
+			reason = "an AND should only feed the carry OR"
+		}
+
+		if reason != "" {
+			violations = append(violations, Violation{Gate: g, Reason: reason})
+		}
+	}
+
+	return violations
+}
+
+// The output wires of the gates with violations, sorted
+func WrongWires(violations []Violation) []string {
+	wires := []string{}
+	for _, v := range violations {
+		wires = append(wires, v.Gate.Output)
+	}
+	slices.Sort(wires)
+	return slices.Compact(wires)
+}
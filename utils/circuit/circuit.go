@@ -0,0 +1,225 @@
+package circuit
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/jmugliston/aoc/graph"
+)
+
+// A circuit of logic gates joined by named wires, e.g.
+//
+//	x00: 1
+//	y00: 0
+//
+//	x00 AND y00 -> z00
+
+// A two input gate (AND, OR or XOR)
+type Gate struct {
+	A      string
+	B      string
+	Op     string
+	Output string
+}
+
+func (g Gate) String() string {
+	return fmt.Sprintf("%s %s %s -> %s", g.A, g.Op, g.B, g.Output)
+}
+
+func (g Gate) HasInput(wire string) bool {
+	return g.A == wire || g.B == wire
+}
+
+type Circuit struct {
+	// Initial wire values
+	Inputs map[string]int
+	Gates  []Gate
+}
+
+// Parse the initial wire values, a blank line, then one gate per line
+func Parse(input string) (*Circuit, error) {
+	c := &Circuit{Inputs: map[string]int{}}
+
+	sections := strings.SplitN(strings.TrimSpace(strings.ReplaceAll(input, "\r\n", "\n")), "\n\n", 2)
+	if len(sections) != 2 {
+		return nil, fmt.Errorf("Expected wire values and gates separated by a blank line")
+	}
+
+	for _, line := range strings.Split(sections[0], "\n") {
+		var wire string
+		var value int
+		if _, err := fmt.Sscanf(strings.Replace(line, ":", " ", 1), "%s %d", &wire, &value); err != nil {
+			return nil, fmt.Errorf("Invalid wire value %q", line)
+		}
+		c.Inputs[wire] = value
+	}
+
+	for _, line := range strings.Split(sections[1], "\n") {
+		var g Gate
+		if _, err := fmt.Sscanf(line, "%s %s %s -> %s", &g.A, &g.Op, &g.B, &g.Output); err != nil {
+			return nil, fmt.Errorf("Invalid gate %q", line)
+		}
+		if g.Op != "AND" && g.Op != "OR" && g.Op != "XOR" {
+			return nil, fmt.Errorf("Unknown gate %s in %q", g.Op, line)
+		}
+		c.Gates = append(c.Gates, g)
+	}
+
+	return c, nil
+}
+
+func (c *Circuit) driver(wire string) (int, bool) {
+	for i, g := range c.Gates {
+		if g.Output == wire {
+			return i, true
+		}
+	}
+	return -1, false
+}
+
+// Gates with the wire as an input
+func (c *Circuit) Consumers(wire string) []Gate {
+	consumers := []Gate{}
+	for _, g := range c.Gates {
+		if g.HasInput(wire) {
+			consumers = append(consumers, g)
+		}
+	}
+	return consumers
+}
+
+// Every wire name starting with a prefix (e.g. "z"), sorted
+func (c *Circuit) Wires(prefix string) []string {
+	wires := []string{}
+	for wire := range c.Inputs {
+		wires = append(wires, wire)
+	}
+	for _, g := range c.Gates {
+		wires = append(wires, g.A, g.B, g.Output)
+	}
+	wires = slices.DeleteFunc(wires, func(wire string) bool { return !strings.HasPrefix(wire, prefix) })
+	slices.Sort(wires)
+	return slices.Compact(wires)
+}
+
+// Swap the output wires of the gates driving a and b
+func (c *Circuit) Swap(a string, b string) error {
+	i, ok := c.driver(a)
+	if !ok {
+		return fmt.Errorf("No gate outputs %s", a)
+	}
+	j, ok := c.driver(b)
+	if !ok {
+		return fmt.Errorf("No gate outputs %s", b)
+	}
+	c.Gates[i].Output, c.Gates[j].Output = c.Gates[j].Output, c.Gates[i].Output
+	return nil
+}
+
+// The value of every wire, given the input values
+// Gates are run in topological order, so an error means a loop or a wire with no value
+func (c *Circuit) Evaluate(inputs map[string]int) (map[string]int, error) {
+	values := map[string]int{}
+	for wire, value := range inputs {
+		values[wire] = value
+	}
+
+	drivers := map[string]Gate{}
+	for _, g := range c.Gates {
+		drivers[g.Output] = g
+	}
+
+	visiting := map[string]bool{}
+
+	var evaluate func(wire string) (int, error)
+	evaluate = func(wire string) (int, error) {
+		if value, ok := values[wire]; ok {
+			return value, nil
+		}
+
+		g, ok := drivers[wire]
+		if !ok {
+			return 0, fmt.Errorf("Wire %s has no value", wire)
+		}
+		if visiting[wire] {
+			return 0, fmt.Errorf("Circuit contains a loop through %s", wire)
+		}
+		visiting[wire] = true
+
+		a, err := evaluate(g.A)
+		if err != nil {
+			return 0, err
+		}
+		b, err := evaluate(g.B)
+		if err != nil {
+			return 0, err
+		}
+
+		switch g.Op {
+		case "AND":
+			values[wire] = a & b
+		case "OR":
+			values[wire] = a | b
+		case "XOR":
+			values[wire] = a ^ b
+		}
+
+		return values[wire], nil
+	}
+
+	for _, g := range c.Gates {
+		if _, err := evaluate(g.Output); err != nil {
+			return nil, err
+		}
+	}
+
+	return values, nil
+}
+
+// The number made by the wires with a prefix, where wire <prefix>00 is the lowest bit
+func Number(values map[string]int, prefix string) int {
+	number := 0
+	for wire, value := range values {
+		if !strings.HasPrefix(wire, prefix) {
+			continue
+		}
+		bit, err := strconv.Atoi(wire[len(prefix):])
+		if err != nil {
+			continue
+		}
+		number |= (value & 1) << bit
+	}
+	return number
+}
+
+// Wire values for a number, from <prefix>00 up to the given number of bits
+func Bits(prefix string, bits int, number int) map[string]int {
+	values := map[string]int{}
+	for bit := 0; bit < bits; bit++ {
+		values[fmt.Sprintf("%s%02d", prefix, bit)] = (number >> bit) & 1
+	}
+	return values
+}
+
+// A graph from each gate's inputs to its output wire (labelled with the gate)
+func (c *Circuit) Graph() graph.Graph {
+	g := graph.Graph{}
+	for _, wire := range c.Wires("") {
+		g.AddNode(wire)
+	}
+	for _, gate := range c.Gates {
+		node, _ := g.GetNode(gate.Output)
+		node.Data = []string{gate.Op}
+		g.AddEdge(gate.A+"-"+gate.Output, gate.A, gate.Output, []string{})
+		g.AddEdge(gate.B+"-"+gate.Output, gate.B, gate.Output, []string{})
+	}
+	return g
+}
+
+// Export the circuit as a graph ("dot" or "mermaid"), highlighting some wires
+func (c *Circuit) Export(format string, highlight map[string]bool) (string, error) {
+	g := c.Graph()
+	return g.Export(format, graph.ExportOptions{HighlightNodes: highlight})
+}
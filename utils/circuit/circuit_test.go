@@ -0,0 +1,137 @@
+package circuit
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func readExample(path string) string {
+	input, err := os.ReadFile(path)
+
+	if err != nil {
+		panic("Couldn't find the example file!")
+	}
+
+	return string(input)
+}
+
+// A correct ripple-carry adder for two numbers of the given number of bits
+func rippleCarryAdder(bits int) *Circuit {
+	c := &Circuit{Inputs: map[string]int{}}
+	gate := func(a, op, b, output string) {
+		c.Gates = append(c.Gates, Gate{A: a, Op: op, B: b, Output: output})
+	}
+
+	gate("x00", "XOR", "y00", "z00")
+	gate("x00", "AND", "y00", "c00")
+
+	for i := 1; i < bits; i++ {
+		carry := fmt.Sprintf("c%02d", i)
+		if i == bits-1 {
+			carry = fmt.Sprintf("z%02d", bits)
+		}
+		gate(fmt.Sprintf("x%02d", i), "XOR", fmt.Sprintf("y%02d", i), fmt.Sprintf("s%02d", i))
+		gate(fmt.Sprintf("x%02d", i), "AND", fmt.Sprintf("y%02d", i), fmt.Sprintf("a%02d", i))
+		gate(fmt.Sprintf("s%02d", i), "XOR", fmt.Sprintf("c%02d", i-1), fmt.Sprintf("z%02d", i))
+		gate(fmt.Sprintf("s%02d", i), "AND", fmt.Sprintf("c%02d", i-1), fmt.Sprintf("b%02d", i))
+		gate(fmt.Sprintf("a%02d", i), "OR", fmt.Sprintf("b%02d", i), carry)
+	}
+
+	return c
+}
+
+func add(c *Circuit, bits int, x int, y int) (int, error) {
+	inputs := Bits("x", bits, x)
+	for wire, value := range Bits("y", bits, y) {
+		inputs[wire] = value
+	}
+	values, err := c.Evaluate(inputs)
+	if err != nil {
+		return 0, err
+	}
+	return Number(values, "z"), nil
+}
+
+func TestEvaluate(t *testing.T) {
+	// 2024 day 24
+	c, err := Parse(readExample("../../2024/day24/input/example.txt"))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	values, err := c.Evaluate(c.Inputs)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if Number(values, "z") != 2024 {
+		t.Errorf("Expected %v, got %v", 2024, Number(values, "z"))
+	}
+
+	if _, err := Parse("x00: 1\n\nx00 NAND y00 -> z00"); err == nil {
+		t.Errorf("Expected an error for an unknown gate")
+	}
+
+	loop := &Circuit{Gates: []Gate{{A: "a", Op: "AND", B: "x", Output: "b"}, {A: "b", Op: "OR", B: "x", Output: "a"}}}
+
+	if _, err := loop.Evaluate(map[string]int{"x": 1}); err == nil {
+		t.Errorf("Expected an error for a loop")
+	}
+}
+
+func TestVerifyAdder(t *testing.T) {
+	c := rippleCarryAdder(16)
+
+	if sum, _ := add(c, 16, 40000, 30000); sum != 70000 {
+		t.Errorf("Expected %v, got %v", 70000, sum)
+	}
+
+	if violations := c.VerifyAdder("x", "y", "z"); len(violations) != 0 {
+		t.Errorf("Expected no violations, got %v", violations)
+	}
+
+	// Each kind of swap from the puzzle
+	swaps := [][2]string{{"s03", "a03"}, {"z07", "c07"}, {"z11", "b11"}, {"z13", "a13"}}
+	for _, swap := range swaps {
+		c.Swap(swap[0], swap[1])
+	}
+
+	if sum, err := add(c, 16, 40000, 30000); err == nil && sum == 70000 {
+		t.Errorf("Expected the swapped circuit to be broken")
+	}
+
+	expected := []string{"a03", "a13", "b11", "c07", "s03", "z07", "z11", "z13"}
+	result := WrongWires(c.VerifyAdder("x", "y", "z"))
+
+	if !slices.Equal(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+
+	// Swapping back fixes it
+	for i := 0; i < len(result); i += 2 {
+		c.Swap(swaps[i/2][0], swaps[i/2][1])
+	}
+
+	if sum, _ := add(c, 16, 40000, 30000); sum != 70000 {
+		t.Errorf("Expected %v, got %v", 70000, sum)
+	}
+}
+
+func TestExport(t *testing.T) {
+	c := rippleCarryAdder(2)
+
+	output, err := c.Export("dot", map[string]bool{"z01": true})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(output, `"z01" [label="z01\nXOR", style=filled`) || !strings.Contains(output, `"s01" -> "z01";`) {
+		t.Errorf("Unexpected export %v", output)
+	}
+}
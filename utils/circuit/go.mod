@@ -0,0 +1,11 @@
+module github.com/jmugliston/aoc/circuit
+
+replace github.com/jmugliston/aoc/graph => ../graph
+
+replace github.com/jmugliston/aoc/utils => ../general
+
+go 1.23
+
+require github.com/jmugliston/aoc/graph v0.0.0-00010101000000-000000000000
+
+require github.com/jmugliston/aoc/utils v0.0.0-00010101000000-000000000000 // indirect
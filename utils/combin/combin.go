@@ -0,0 +1,207 @@
+package combin
+
+import (
+	"cmp"
+	"errors"
+	"iter"
+	"math"
+	"math/bits"
+	"slices"
+)
+
+// Lazy combinatorics - each sequence yields a new slice every time,
+// so the values can be kept without copying
+
+var ErrOverflow = errors.New("Result overflows an int")
+
+// Every unordered pair of items (i before j)
+func Pairs[T any](items []T) iter.Seq2[T, T] {
+	return func(yield func(T, T) bool) {
+		for i := 0; i < len(items); i++ {
+			for j := i + 1; j < len(items); j++ {
+				if !yield(items[i], items[j]) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// The indices of every k-combination of n items, in lexicographic order
+func CombinationIndices(n int, k int) iter.Seq[[]int] {
+	return func(yield func([]int) bool) {
+		if k < 0 || k > n {
+			return
+		}
+
+		indices := make([]int, k)
+		for i := range indices {
+			indices[i] = i
+		}
+
+		for {
+			if !yield(slices.Clone(indices)) {
+				return
+			}
+
+			// Find the rightmost index that can still move right
+			i := k - 1
+			for i >= 0 && indices[i] == n-k+i {
+				i--
+			}
+			if i < 0 {
+				return
+			}
+
+			indices[i]++
+			for j := i + 1; j < k; j++ {
+				indices[j] = indices[j-1] + 1
+			}
+		}
+	}
+}
+
+// Every way to choose k of the items (ignoring order)
+func Combinations[T any](items []T, k int) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		for indices := range CombinationIndices(len(items), k) {
+			if !yield(pick(items, indices)) {
+				return
+			}
+		}
+	}
+}
+
+func pick[T any](items []T, indices []int) []T {
+	picked := make([]T, len(indices))
+	for i, index := range indices {
+		picked[i] = items[index]
+	}
+	return picked
+}
+
+// Narayana's algorithm - https://en.wikipedia.org/wiki/Permutation#Generation_in_lexicographic_order
+// Rearranges values into the next permutation, returning false after the last one
+func nextPermutation[T any](values []T, compare func(a, b T) int) bool {
+	i := len(values) - 2
+	for i >= 0 && compare(values[i], values[i+1]) >= 0 {
+		i--
+	}
+	if i < 0 {
+		return false
+	}
+
+	j := len(values) - 1
+	for compare(values[j], values[i]) <= 0 {
+		j--
+	}
+
+	values[i], values[j] = values[j], values[i]
+	slices.Reverse(values[i+1:])
+
+	return true
+}
+
+// Every ordering of the items (equal items are still treated as different)
+func Permutations[T any](items []T) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		indices := make([]int, len(items))
+		for i := range indices {
+			indices[i] = i
+		}
+
+		for {
+			if !yield(pick(items, indices)) {
+				return
+			}
+			if !nextPermutation(indices, cmp.Compare[int]) {
+				return
+			}
+		}
+	}
+}
+
+// Every distinct ordering of the items, in sorted order (so "aab" gives 3, not 6)
+func MultisetPermutations[T cmp.Ordered](items []T) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		values := slices.Clone(items)
+		slices.Sort(values)
+
+		for {
+			if !yield(slices.Clone(values)) {
+				return
+			}
+			if !nextPermutation(values, cmp.Compare[T]) {
+				return
+			}
+		}
+	}
+}
+
+// Cartesian product - every way to take one item from each set, varying the last set fastest
+func Product[T any](sets ...[]T) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		for _, set := range sets {
+			if len(set) == 0 {
+				return
+			}
+		}
+
+		indices := make([]int, len(sets))
+		for {
+			tuple := make([]T, len(sets))
+			for i, index := range indices {
+				tuple[i] = sets[i][index]
+			}
+			if !yield(tuple) {
+				return
+			}
+
+			// Count up like an odometer
+			i := len(sets) - 1
+			for i >= 0 {
+				indices[i]++
+				if indices[i] < len(sets[i]) {
+					break
+				}
+				indices[i] = 0
+				i--
+			}
+			if i < 0 {
+				return
+			}
+		}
+	}
+}
+
+// Every way to take n items (with repeats) from the same set
+func Repeat[T any](items []T, n int) iter.Seq[[]T] {
+	sets := make([][]T, n)
+	for i := range sets {
+		sets[i] = items
+	}
+	return Product(sets...)
+}
+
+// The number of ways to choose k of n items, or an error if it overflows an int
+func Binomial(n int, k int) (int, error) {
+	if k < 0 || k > n {
+		return 0, nil
+	}
+	k = min(k, n-k)
+
+	// Each step is exact - result is C(n, i + 1) afterwards
+	result := uint64(1)
+	for i := 0; i < k; i++ {
+		hi, lo := bits.Mul64(result, uint64(n-i))
+		if hi >= uint64(i+1) {
+			return 0, ErrOverflow
+		}
+		result, _ = bits.Div64(hi, lo, uint64(i+1))
+		if result > math.MaxInt {
+			return 0, ErrOverflow
+		}
+	}
+
+	return int(result), nil
+}
@@ -0,0 +1,132 @@
+package combin
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func collect[T any](seq iter.Seq[[]T]) []string {
+	result := []string{}
+	for values := range seq {
+		result = append(result, fmt.Sprint(values))
+	}
+	return result
+}
+
+func TestPairs(t *testing.T) {
+	pairs := []string{}
+	for a, b := range Pairs([]string{"a", "b", "c"}) {
+		pairs = append(pairs, a+b)
+	}
+
+	expected := []string{"ab", "ac", "bc"}
+
+	if !slices.Equal(pairs, expected) {
+		t.Errorf("Expected %v, got %v", expected, pairs)
+	}
+
+	// Stopping early
+	count := 0
+	for range Pairs([]int{1, 2, 3, 4, 5}) {
+		count++
+		if count == 2 {
+			break
+		}
+	}
+
+	if count != 2 {
+		t.Errorf("Expected %v, got %v", 2, count)
+	}
+}
+
+func TestCombinations(t *testing.T) {
+	expected := []string{"[1 2]", "[1 3]", "[1 4]", "[2 3]", "[2 4]", "[3 4]"}
+	result := collect(Combinations([]int{1, 2, 3, 4}, 2))
+
+	if !slices.Equal(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+
+	if len(collect(Combinations([]int{1, 2}, 3))) != 0 || len(collect(Combinations([]int{1, 2}, 0))) != 1 {
+		t.Errorf("Unexpected combinations for k outside 1..n")
+	}
+}
+
+func TestPermutations(t *testing.T) {
+	expected := []string{"[a b c]", "[a c b]", "[b a c]", "[b c a]", "[c a b]", "[c b a]"}
+	result := collect(Permutations([]string{"a", "b", "c"}))
+
+	if !slices.Equal(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+
+	if len(collect(Permutations([]string{"a", "a", "b"}))) != 6 {
+		t.Errorf("Expected %v, got %v", 6, len(collect(Permutations([]string{"a", "a", "b"}))))
+	}
+
+	expected = []string{"[a a b]", "[a b a]", "[b a a]"}
+	result = collect(MultisetPermutations([]string{"b", "a", "a"}))
+
+	if !slices.Equal(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestProduct(t *testing.T) {
+	expected := []string{"[0 x]", "[0 y]", "[1 x]", "[1 y]", "[2 x]", "[2 y]"}
+	result := collect(Product([]string{"0", "1", "2"}, []string{"x", "y"}))
+
+	if !slices.Equal(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+
+	// 2024 day 7 - every way to fill the gaps in "3 ? 4 ? 5" with + or *
+	operators := []string{}
+	for ops := range Repeat([]string{"+", "*"}, 2) {
+		operators = append(operators, strings.Join(ops, ""))
+	}
+
+	if !slices.Equal(operators, []string{"++", "+*", "*+", "**"}) {
+		t.Errorf("Unexpected operators %v", operators)
+	}
+
+	if len(collect(Product[int]())) != 1 || len(collect(Product([]int{1}, []int{}))) != 0 {
+		t.Errorf("Unexpected products of empty sets")
+	}
+}
+
+func TestBinomial(t *testing.T) {
+	tests := map[[2]int]int{
+		{4, 2}:   6,
+		{10, 0}:  1,
+		{10, 11}: 0,
+		{52, 5}:  2598960,
+		{66, 33}: 7219428434016265740,
+	}
+
+	for args, expected := range tests {
+		result, err := Binomial(args[0], args[1])
+
+		if err != nil || result != expected {
+			t.Errorf("Expected %v, got %v (%v)", expected, result, err)
+		}
+	}
+
+	if _, err := Binomial(68, 34); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected %v, got %v", ErrOverflow, err)
+	}
+
+	// The number of pairs matches the count
+	count := 0
+	for range CombinationIndices(20, 2) {
+		count++
+	}
+
+	if expected, _ := Binomial(20, 2); count != expected {
+		t.Errorf("Expected %v, got %v", expected, count)
+	}
+}
@@ -0,0 +1,3 @@
+module github.com/jmugliston/aoc/combin
+
+go 1.23
@@ -0,0 +1,115 @@
+package cycle
+
+// A sequence of states that starts repeating after Start steps, every Length steps
+type Cycle[S any] struct {
+	Start  int
+	Length int
+
+	initial S
+	step    func(S) S
+	// States from the initial state up to the end of the first loop (hash-based detection only)
+	states []S
+}
+
+// Index of the state that the Nth state is identical to, within the first loop
+func (c Cycle[S]) Index(n int) int {
+	if n < c.Start {
+		return n
+	}
+	return c.Start + (n-c.Start)%c.Length
+}
+
+// The state after n steps, without simulating all of them
+func (c Cycle[S]) Nth(n int) S {
+	index := c.Index(n)
+
+	if index < len(c.states) {
+		return c.states[index]
+	}
+
+	state := c.initial
+	for i := 0; i < index; i++ {
+		state = c.step(state)
+	}
+	return state
+}
+
+// Hash-based detection - remembers the key of every state until one repeats
+// Uses more memory than Floyd/Brent but only steps through the sequence once,
+// and Nth returns stored states directly
+func Detect[S any, K comparable](initial S, step func(S) S, key func(S) K) Cycle[S] {
+	seen := map[K]int{}
+	states := []S{}
+
+	state := initial
+	for i := 0; ; i++ {
+		k := key(state)
+		if first, ok := seen[k]; ok {
+			return Cycle[S]{Start: first, Length: i - first, initial: initial, step: step, states: states}
+		}
+		seen[k] = i
+		states = append(states, state)
+		state = step(state)
+	}
+}
+
+// Floyd's "tortoise and hare" algorithm
+// https://en.wikipedia.org/wiki/Cycle_detection#Floyd's_tortoise_and_hare
+func Floyd[S any, K comparable](initial S, step func(S) S, key func(S) K) Cycle[S] {
+	tortoise := step(initial)
+	hare := step(step(initial))
+	for key(tortoise) != key(hare) {
+		tortoise = step(tortoise)
+		hare = step(step(hare))
+	}
+
+	// Find the start of the cycle
+	start := 0
+	tortoise = initial
+	for key(tortoise) != key(hare) {
+		tortoise = step(tortoise)
+		hare = step(hare)
+		start++
+	}
+
+	// Find the length of the cycle
+	length := 1
+	hare = step(tortoise)
+	for key(tortoise) != key(hare) {
+		hare = step(hare)
+		length++
+	}
+
+	return Cycle[S]{Start: start, Length: length, initial: initial, step: step}
+}
+
+// Brent's algorithm - finds the cycle length first, using fewer steps than Floyd
+// https://en.wikipedia.org/wiki/Cycle_detection#Brent's_algorithm
+func Brent[S any, K comparable](initial S, step func(S) S, key func(S) K) Cycle[S] {
+	power, length := 1, 1
+	tortoise := initial
+	hare := step(initial)
+	for key(tortoise) != key(hare) {
+		if power == length {
+			tortoise = hare
+			power *= 2
+			length = 0
+		}
+		hare = step(hare)
+		length++
+	}
+
+	// Move the hare ahead by the cycle length, then find where they meet
+	start := 0
+	tortoise, hare = initial, initial
+	for i := 0; i < length; i++ {
+		hare = step(hare)
+	}
+	for key(tortoise) != key(hare) {
+		tortoise = step(tortoise)
+		hare = step(hare)
+		start++
+	}
+
+	return Cycle[S]{Start: start, Length: length, initial: initial, step: step}
+}
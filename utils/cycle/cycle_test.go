@@ -0,0 +1,71 @@
+package cycle
+
+import (
+	"testing"
+)
+
+func identity(x int) int {
+	return x
+}
+
+// x -> (x*x + 1) mod 255, starting at 3 - 3, 10, 101, 2, 5, 26, 167, 95, 101, ...
+func step(x int) int {
+	return (x*x + 1) % 255
+}
+
+func TestDetectors(t *testing.T) {
+	detectors := map[string]func(int, func(int) int, func(int) int) Cycle[int]{
+		"Detect": Detect[int, int],
+		"Floyd":  Floyd[int, int],
+		"Brent":  Brent[int, int],
+	}
+
+	for name, detect := range detectors {
+		c := detect(3, step, identity)
+
+		if c.Start != 2 || c.Length != 6 {
+			t.Errorf("%s: Expected start 2 and length 6, got %v and %v", name, c.Start, c.Length)
+		}
+
+		// Compare against simulating every step
+		state := 3
+		for n := 0; n < 50; n++ {
+			if c.Nth(n) != state {
+				t.Errorf("%s: Expected %v, got %v", name, state, c.Nth(n))
+			}
+			state = step(state)
+		}
+
+		expected := 5
+		result := c.Nth(1_000_000_000)
+
+		if result != expected {
+			t.Errorf("%s: Expected %v, got %v", name, expected, result)
+		}
+	}
+}
+
+func TestDetectWithKey(t *testing.T) {
+	// States only repeat on their key - the step count is carried along
+	type state struct {
+		value int
+		steps int
+	}
+
+	c := Detect(
+		state{value: 0},
+		func(s state) state { return state{value: (s.value + 1) % 4, steps: s.steps + 1} },
+		func(s state) int { return s.value },
+	)
+
+	if c.Start != 0 || c.Length != 4 {
+		t.Errorf("Expected start 0 and length 4, got %v and %v", c.Start, c.Length)
+	}
+
+	expected := state{value: 3, steps: 3}
+	result := c.Nth(1_000_003)
+
+	if result != expected {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
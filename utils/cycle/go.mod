@@ -0,0 +1,3 @@
+module github.com/jmugliston/aoc/cycle
+
+go 1.22.2
@@ -0,0 +1,124 @@
+package utils
+
+import (
+	"cmp"
+)
+
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Fold the values into a single result, starting from initial
+func Reduce[T, A any](values []T, initial A, fn func(A, T) A) A {
+	result := initial
+	for _, value := range values {
+		result = fn(result, value)
+	}
+	return result
+}
+
+// Values grouped by a key, keeping their original order within each group
+func GroupBy[T any, K comparable](values []T, key func(T) K) map[K][]T {
+	groups := map[K][]T{}
+	for _, value := range values {
+		k := key(value)
+		groups[k] = append(groups[k], value)
+	}
+	return groups
+}
+
+// Number of values with each key
+func CountBy[T any, K comparable](values []T, key func(T) K) map[K]int {
+	counts := map[K]int{}
+	for _, value := range values {
+		counts[key(value)]++
+	}
+	return counts
+}
+
+// Number of times each value appears
+func Frequencies[T comparable](values []T) map[T]int {
+	counts := map[T]int{}
+	for _, value := range values {
+		counts[value]++
+	}
+	return counts
+}
+
+// Split into consecutive chunks of size (the last one may be shorter)
+func Chunk[T any](values []T, size int) [][]T {
+	chunks := [][]T{}
+	for chunk := range Chunks(values, size) {
+		chunks = append(chunks, chunk)
+	}
+	return chunks
+}
+
+// Every run of size consecutive values (a sliding window)
+func Window[T any](values []T, size int) [][]T {
+	windows := [][]T{}
+	for window := range Windows(values, size) {
+		windows = append(windows, window)
+	}
+	return windows
+}
+
+// Pairs of values at the same index, up to the length of the shorter slice
+func Zip[A, B any](a []A, b []B) []Pair[A, B] {
+	pairs := make([]Pair[A, B], 0, min(len(a), len(b)))
+	for i := 0; i < min(len(a), len(b)); i++ {
+		pairs = append(pairs, Pair[A, B]{First: a[i], Second: b[i]})
+	}
+	return pairs
+}
+
+// The first value with the smallest key (the zero value if there are none)
+func MinBy[T any, K cmp.Ordered](values []T, key func(T) K) T {
+	var best T
+	var bestKey K
+	for i, value := range values {
+		if k := key(value); i == 0 || k < bestKey {
+			best, bestKey = value, k
+		}
+	}
+	return best
+}
+
+// The first value with the largest key (the zero value if there are none)
+func MaxBy[T any, K cmp.Ordered](values []T, key func(T) K) T {
+	var best T
+	var bestKey K
+	for i, value := range values {
+		if k := key(value); i == 0 || k > bestKey {
+			best, bestKey = value, k
+		}
+	}
+	return best
+}
+
+// The values without duplicates, keeping the first of each
+func Uniq[T comparable](values []T) []T {
+	result := []T{}
+	for value := range UniqSeq(Seq(values)) {
+		result = append(result, value)
+	}
+	return result
+}
+
+// Swap rows and columns (rows shorter than the first are padded with the zero value)
+func Transpose[T any](values [][]T) [][]T {
+	if len(values) == 0 {
+		return [][]T{}
+	}
+	transposed := make([][]T, len(values[0]))
+	for x := range transposed {
+		transposed[x] = make([]T, len(values))
+		for y, row := range values {
+			if x < len(row) {
+				transposed[x][y] = row[x]
+			}
+		}
+	}
+	return transposed
+}
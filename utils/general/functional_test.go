@@ -0,0 +1,122 @@
+package utils
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestReduceAndGroup(t *testing.T) {
+	words := []string{"apple", "bean", "avocado", "beet", "cherry"}
+
+	longest := Reduce(words, "", func(best string, word string) string {
+		if len(word) > len(best) {
+			return word
+		}
+		return best
+	})
+
+	if longest != "avocado" {
+		t.Errorf("Expected %v, got %v", "avocado", longest)
+	}
+
+	first := func(word string) byte { return word[0] }
+	groups := GroupBy(words, first)
+
+	if !slices.Equal(groups['b'], []string{"bean", "beet"}) || len(groups) != 3 {
+		t.Errorf("Unexpected groups %v", groups)
+	}
+
+	if !maps.Equal(CountBy(words, first), map[byte]int{'a': 2, 'b': 2, 'c': 1}) {
+		t.Errorf("Unexpected counts %v", CountBy(words, first))
+	}
+
+	if !maps.Equal(Frequencies(strings.Split("32T3K", "")), map[string]int{"3": 2, "2": 1, "T": 1, "K": 1}) {
+		t.Errorf("Unexpected frequencies %v", Frequencies(strings.Split("32T3K", "")))
+	}
+
+	if MinBy(words, func(w string) int { return len(w) }) != "bean" || MaxBy(words, func(w string) int { return len(w) }) != "avocado" {
+		t.Errorf("Expected %v and %v", "bean", "avocado")
+	}
+
+	if !slices.Equal(Uniq([]int{3, 1, 3, 2, 1}), []int{3, 1, 2}) {
+		t.Errorf("Expected %v, got %v", []int{3, 1, 2}, Uniq([]int{3, 1, 3, 2, 1}))
+	}
+}
+
+func TestSlicing(t *testing.T) {
+	values := []int{1, 2, 3, 4, 5}
+
+	if fmt.Sprint(Chunk(values, 2)) != "[[1 2] [3 4] [5]]" {
+		t.Errorf("Expected %v, got %v", "[[1 2] [3 4] [5]]", Chunk(values, 2))
+	}
+
+	if fmt.Sprint(Window(values, 3)) != "[[1 2 3] [2 3 4] [3 4 5]]" {
+		t.Errorf("Expected %v, got %v", "[[1 2 3] [2 3 4] [3 4 5]]", Window(values, 3))
+	}
+
+	if fmt.Sprint(Zip(values, []string{"a", "b"})) != "[{1 a} {2 b}]" {
+		t.Errorf("Expected %v, got %v", "[{1 a} {2 b}]", Zip(values, []string{"a", "b"}))
+	}
+
+	transposed := Transpose([][]string{{"a", "b", "c"}, {"d", "e", "f"}})
+
+	if fmt.Sprint(transposed) != "[[a d] [b e] [c f]]" {
+		t.Errorf("Expected %v, got %v", "[[a d] [b e] [c f]]", transposed)
+	}
+
+	if !slices.Equal(RemoveIndex([]string{"a", "b", "c"}, 1), []string{"a", "c"}) {
+		t.Errorf("Expected %v, got %v", []string{"a", "c"}, RemoveIndex([]string{"a", "b", "c"}, 1))
+	}
+}
+
+func TestSlicingInvalidSize(t *testing.T) {
+	for _, size := range []int{0, -1} {
+		for name, f := range map[string]func(){
+			"Chunk":  func() { Chunk([]int{1, 2, 3}, size) },
+			"Window": func() { Window([]int{1, 2, 3}, size) },
+		} {
+			func() {
+				defer func() {
+					if recover() == nil {
+						t.Errorf("Expected %v with size %v to panic", name, size)
+					}
+				}()
+				f()
+			}()
+		}
+	}
+}
+
+func TestNumbers(t *testing.T) {
+	if Sum([]float64{0.5, 1.5, 2}) != 4 || Product([]int64{2, 3, 4}) != 24 || Abs(-2.5) != 2.5 {
+		t.Errorf("Unexpected numeric results")
+	}
+
+	// Sum of the squares of the odd numbers, without building any slices
+	odd := FilterSeq(Seq([]int{1, 2, 3, 4, 5}), func(n int) bool { return n%2 == 1 })
+	squares := MapSeq(odd, func(n int) int { return n * n })
+
+	if SumSeq(squares) != 35 {
+		t.Errorf("Expected %v, got %v", 35, SumSeq(squares))
+	}
+
+	if ProductSeq(Seq([]int{1, 2, 3, 4})) != 24 || ReduceSeq(Seq([]int{1, 2, 3}), 10, func(a, n int) int { return a - n }) != 4 {
+		t.Errorf("Unexpected sequence results")
+	}
+
+	if !slices.Equal(Collect(UniqSeq(Seq([]int{1, 1, 2}))), []int{1, 2}) {
+		t.Errorf("Expected %v, got %v", []int{1, 2}, Collect(UniqSeq(Seq([]int{1, 1, 2}))))
+	}
+
+	total := 0
+	for a, b := range ZipSeq([]int{1, 2, 3}, []int{10, 20}) {
+		total += a * b
+	}
+
+	if total != 50 {
+		t.Errorf("Expected %v, got %v", 50, total)
+	}
+}
@@ -1,9 +1,5 @@
 package utils
 
-import (
-	"math"
-)
-
 func Values[M ~map[K]V, K comparable, V any](m M) []V {
 	r := make([]V, 0, len(m))
 	for _, v := range m {
@@ -38,8 +34,8 @@ func IndexOf(limit int, predicate func(i int) bool) int {
 	return -1
 }
 
-func RemoveIndex(s []int, index int) []int {
-	ret := make([]int, 0)
+func RemoveIndex[T any](s []T, index int) []T {
+	ret := make([]T, 0, len(s)-1)
 	ret = append(ret, s[:index]...)
 	return append(ret, s[index+1:]...)
 }
@@ -54,16 +50,23 @@ func EveryNthElement[T any](values []T, n int) []T {
 	return result
 }
 
-func Sum(values []int) int {
-	sum := 0
+// Any integer or float type (like constraints.Integer | constraints.Float)
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+func Sum[T Number](values []T) T {
+	var sum T
 	for _, value := range values {
 		sum += value
 	}
 	return sum
 }
 
-func Product(values []int) int {
-	product := 1
+func Product[T Number](values []T) T {
+	var product T = 1
 	for _, value := range values {
 		product *= value
 	}
@@ -77,15 +80,19 @@ func GCD(a, b int) int {
 	return a
 }
 
+// Divides before multiplying, so only overflows if the result does
 func LCM(values []int) int {
-	lcm := values[0]
+	lcm := Abs(values[0])
 	for _, value := range values[1:] {
-		lcm = int(math.Abs(float64(lcm*value)) / float64(GCD(lcm, value)))
+		if lcm == 0 || value == 0 {
+			return 0
+		}
+		lcm = lcm / Abs(GCD(lcm, value)) * Abs(value)
 	}
 	return lcm
 }
 
-func Abs(x int) int {
+func Abs[T Number](x T) T {
 	if x < 0 {
 		return -x
 	}
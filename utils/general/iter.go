@@ -0,0 +1,139 @@
+package utils
+
+import (
+	"fmt"
+	"iter"
+)
+
+// Iterator versions of the slice helpers, which don't build intermediate slices
+//
+//	utils.SumSeq(utils.MapSeq(utils.Seq(lines), parse))
+
+// The values of a slice as a sequence
+func Seq[T any](values []T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, value := range values {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// Collect a sequence into a slice
+func Collect[T any](seq iter.Seq[T]) []T {
+	result := []T{}
+	for value := range seq {
+		result = append(result, value)
+	}
+	return result
+}
+
+func MapSeq[T, V any](seq iter.Seq[T], fn func(T) V) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for value := range seq {
+			if !yield(fn(value)) {
+				return
+			}
+		}
+	}
+}
+
+func FilterSeq[T any](seq iter.Seq[T], test func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for value := range seq {
+			if test(value) && !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+func ReduceSeq[T, A any](seq iter.Seq[T], initial A, fn func(A, T) A) A {
+	result := initial
+	for value := range seq {
+		result = fn(result, value)
+	}
+	return result
+}
+
+func SumSeq[T Number](seq iter.Seq[T]) T {
+	var sum T
+	for value := range seq {
+		sum += value
+	}
+	return sum
+}
+
+func ProductSeq[T Number](seq iter.Seq[T]) T {
+	var product T = 1
+	for value := range seq {
+		product *= value
+	}
+	return product
+}
+
+func FrequenciesSeq[T comparable](seq iter.Seq[T]) map[T]int {
+	counts := map[T]int{}
+	for value := range seq {
+		counts[value]++
+	}
+	return counts
+}
+
+// The values of a sequence without duplicates, keeping the first of each
+func UniqSeq[T comparable](seq iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		seen := map[T]bool{}
+		for value := range seq {
+			if seen[value] {
+				continue
+			}
+			seen[value] = true
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// Consecutive chunks of size - each chunk is a sub-slice of values, not a copy
+// Panics if size isn't positive
+func Chunks[T any](values []T, size int) iter.Seq[[]T] {
+	if size <= 0 {
+		panic(fmt.Sprintf("Chunk size must be positive, got %d", size))
+	}
+	return func(yield func([]T) bool) {
+		for start := 0; start < len(values); start += size {
+			if !yield(values[start:min(start+size, len(values))]) {
+				return
+			}
+		}
+	}
+}
+
+// Every run of size consecutive values - each window is a sub-slice of values, not a copy
+// Panics if size isn't positive
+func Windows[T any](values []T, size int) iter.Seq[[]T] {
+	if size <= 0 {
+		panic(fmt.Sprintf("Window size must be positive, got %d", size))
+	}
+	return func(yield func([]T) bool) {
+		for start := 0; start+size <= len(values); start++ {
+			if !yield(values[start : start+size]) {
+				return
+			}
+		}
+	}
+}
+
+// Values at the same index, up to the length of the shorter slice
+func ZipSeq[A, B any](a []A, b []B) iter.Seq2[A, B] {
+	return func(yield func(A, B) bool) {
+		for i := 0; i < min(len(a), len(b)); i++ {
+			if !yield(a[i], b[i]) {
+				return
+			}
+		}
+	}
+}
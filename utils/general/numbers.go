@@ -0,0 +1,156 @@
+package utils
+
+import (
+	"fmt"
+	"math/big"
+	"math/bits"
+	"slices"
+)
+
+// Extended Euclidean algorithm - https://en.wikipedia.org/wiki/Extended_Euclidean_algorithm
+// Returns the GCD and x, y such that a*x + b*y = gcd
+func ExtendedGCD(a, b int) (int, int, int) {
+	oldR, r := a, b
+	oldX, x := 1, 0
+	oldY, y := 0, 1
+
+	for r != 0 {
+		quotient := oldR / r
+		oldR, r = r, oldR-quotient*r
+		oldX, x = x, oldX-quotient*x
+		oldY, y = y, oldY-quotient*y
+	}
+
+	if oldR < 0 {
+		return -oldR, -oldX, -oldY
+	}
+	return oldR, oldX, oldY
+}
+
+// The remainder of a / m, always between 0 and m - 1
+func Mod(a, m int) int {
+	return ((a % m) + m) % m
+}
+
+// The x where a*x = 1 (mod m), if there is one
+func ModInverse(a, m int) (int, error) {
+	g, x, _ := ExtendedGCD(Mod(a, m), m)
+	if g != 1 {
+		return 0, fmt.Errorf("%d has no inverse modulo %d", a, m)
+	}
+	return Mod(x, m), nil
+}
+
+// a*b mod m without overflowing
+func mulMod(a, b, m int) int {
+	hi, lo := bits.Mul64(uint64(a), uint64(b))
+	return int(bits.Rem64(hi, lo, uint64(m)))
+}
+
+// Exponentiation by squaring - https://en.wikipedia.org/wiki/Modular_exponentiation
+func ModPow(base, exponent, m int) int {
+	if m == 1 {
+		return 0
+	}
+
+	result := 1
+	base = Mod(base, m)
+	for exponent > 0 {
+		if exponent&1 == 1 {
+			result = mulMod(result, base, m)
+		}
+		base = mulMod(base, base, m)
+		exponent >>= 1
+	}
+	return result
+}
+
+// Chinese remainder theorem - https://en.wikipedia.org/wiki/Chinese_remainder_theorem
+// Finds the smallest x >= 0 with x = remainders[i] (mod moduli[i]) for every i
+// The moduli don't need to be coprime, but then the remainders must agree
+// Returns x and the modulus of the combined solution (the LCM of the moduli)
+func CRT(remainders []int, moduli []int) (*big.Int, *big.Int, error) {
+	if len(remainders) != len(moduli) {
+		return nil, nil, fmt.Errorf("Expected %d moduli, got %d", len(remainders), len(moduli))
+	}
+
+	x, m := big.NewInt(0), big.NewInt(1)
+
+	for i := range remainders {
+		r, n := big.NewInt(int64(remainders[i])), big.NewInt(int64(moduli[i]))
+		r.Mod(r, n)
+
+		// Solve x + m*k = r (mod n) for k
+		g, inverse := new(big.Int), new(big.Int)
+		g.GCD(inverse, nil, m, n)
+
+		diff := new(big.Int).Sub(r, x)
+		if new(big.Int).Mod(diff, g).Sign() != 0 {
+			return nil, nil, fmt.Errorf("No solution for x = %d (mod %d) and x = %d (mod %d)", x, m, remainders[i], moduli[i])
+		}
+
+		step := new(big.Int).Quo(n, g)
+		k := new(big.Int).Quo(diff, g)
+		k.Mul(k, inverse)
+		k.Mod(k, step)
+
+		x.Add(x, k.Mul(k, m))
+		m.Mul(m, step)
+		x.Mod(x, m)
+	}
+
+	return x, m, nil
+}
+
+// Sieve of Eratosthenes - https://en.wikipedia.org/wiki/Sieve_of_Eratosthenes
+// Returns every prime up to and including n
+func PrimeSieve(n int) []int {
+	if n < 2 {
+		return []int{}
+	}
+
+	composite := make([]bool, n+1)
+	primes := []int{}
+	for i := 2; i <= n; i++ {
+		if composite[i] {
+			continue
+		}
+		primes = append(primes, i)
+		for j := i * i; j <= n; j += i {
+			composite[j] = true
+		}
+	}
+	return primes
+}
+
+// Prime factors of n (by trial division), mapped to their powers
+func Factorize(n int) map[int]int {
+	factors := map[int]int{}
+	n = Abs(n)
+	for p := 2; p*p <= n; p++ {
+		for n%p == 0 {
+			factors[p]++
+			n /= p
+		}
+	}
+	if n > 1 {
+		factors[n]++
+	}
+	return factors
+}
+
+// Every positive number that divides n, smallest first
+func Divisors(n int) []int {
+	divisors := []int{}
+	n = Abs(n)
+	for d := 1; d*d <= n; d++ {
+		if n%d == 0 {
+			divisors = append(divisors, d)
+			if d != n/d {
+				divisors = append(divisors, n/d)
+			}
+		}
+	}
+	slices.Sort(divisors)
+	return divisors
+}
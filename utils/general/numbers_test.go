@@ -0,0 +1,107 @@
+package utils
+
+import (
+	"maps"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestLCM(t *testing.T) {
+	// The float version overflowed on the product before dividing
+	expected := 4000000000000000000
+	result := LCM([]int{2000000000000000000, 4000000000000000000})
+
+	if result != expected {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+
+	if LCM([]int{2, 3, 4, -6}) != 12 {
+		t.Errorf("Expected %v, got %v", 12, LCM([]int{2, 3, 4, -6}))
+	}
+}
+
+func TestModularArithmetic(t *testing.T) {
+	g, x, y := ExtendedGCD(240, 46)
+
+	if g != 2 || 240*x+46*y != 2 {
+		t.Errorf("Expected %v, got %v (x=%v, y=%v)", 2, g, x, y)
+	}
+
+	inverse, err := ModInverse(3, 11)
+
+	if err != nil || inverse != 4 {
+		t.Errorf("Expected %v, got %v", 4, inverse)
+	}
+
+	if _, err := ModInverse(4, 8); err == nil {
+		t.Errorf("Expected an error for a number with no inverse")
+	}
+
+	if Mod(-7, 3) != 2 {
+		t.Errorf("Expected %v, got %v", 2, Mod(-7, 3))
+	}
+
+	if ModPow(4, 13, 497) != 445 {
+		t.Errorf("Expected %v, got %v", 445, ModPow(4, 13, 497))
+	}
+
+	// Large enough moduli would overflow a plain multiplication
+	if ModPow(2, 1<<40, 4611686018427387847) != ModPow(ModPow(2, 1<<20, 4611686018427387847), 1<<20, 4611686018427387847) {
+		t.Errorf("Expected the powers to agree")
+	}
+}
+
+func TestCRT(t *testing.T) {
+	// 2020 day 13 - bus x departs at t + (its index in the list)
+	remainders, moduli := []int{}, []int{}
+	for i, bus := range strings.Split("7,13,x,x,59,x,31,19", ",") {
+		if id, err := strconv.Atoi(bus); err == nil {
+			remainders = append(remainders, -i)
+			moduli = append(moduli, id)
+		}
+	}
+
+	x, m, err := CRT(remainders, moduli)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if x.Cmp(big.NewInt(1068781)) != 0 || m.Cmp(big.NewInt(7*13*59*31*19)) != 0 {
+		t.Errorf("Expected %v, got %v", 1068781, x)
+	}
+
+	// Moduli with common factors
+	x, m, err = CRT([]int{3, 5}, []int{4, 6})
+
+	if err != nil || x.Int64() != 11 || m.Int64() != 12 {
+		t.Errorf("Expected %v, got %v (mod %v)", 11, x, m)
+	}
+
+	if _, _, err = CRT([]int{1, 2}, []int{4, 6}); err == nil {
+		t.Errorf("Expected an error for remainders that disagree")
+	}
+}
+
+func TestPrimes(t *testing.T) {
+	expected := []int{2, 3, 5, 7, 11, 13, 17, 19}
+
+	if !slices.Equal(PrimeSieve(20), expected) {
+		t.Errorf("Expected %v, got %v", expected, PrimeSieve(20))
+	}
+
+	factors := Factorize(360)
+
+	if !maps.Equal(factors, map[int]int{2: 3, 3: 2, 5: 1}) {
+		t.Errorf("Expected %v, got %v", "2^3 3^2 5", factors)
+	}
+
+	divisors := []int{1, 2, 3, 4, 6, 9, 12, 18, 36}
+
+	if !slices.Equal(Divisors(36), divisors) {
+		t.Errorf("Expected %v, got %v", divisors, Divisors(36))
+	}
+}
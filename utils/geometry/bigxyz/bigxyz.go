@@ -1,57 +1,69 @@
 package bigxyz
 
 import (
-	"math/big"
+	"fmt"
 
 	"github.com/jmugliston/aoc/bigInt"
 )
 
 type Coord struct {
-	X *big.Int
-	Y *big.Int
-	Z *big.Int
+	X bigInt.Int
+	Y bigInt.Int
+	Z bigInt.Int
 }
 
-func Dot(a Coord, b Coord) *big.Int {
-	return bigInt.Add(bigInt.Add(bigInt.Mul(a.X, b.X), bigInt.Mul(a.Y, b.Y)), bigInt.Mul(a.Z, b.Z))
+func New(x, y, z int) Coord {
+	return Coord{X: bigInt.New(x), Y: bigInt.New(y), Z: bigInt.New(z)}
+}
+
+func Equal(a Coord, b Coord) bool {
+	return a.X.Equal(b.X) && a.Y.Equal(b.Y) && a.Z.Equal(b.Z)
+}
+
+func (c Coord) String() string {
+	return fmt.Sprintf("%s,%s,%s", c.X, c.Y, c.Z)
+}
+
+func Dot(a Coord, b Coord) bigInt.Int {
+	return a.X.Mul(b.X).Add(a.Y.Mul(b.Y)).Add(a.Z.Mul(b.Z))
 }
 
 func Cross(a Coord, b Coord) Coord {
 	return Coord{
-		X: bigInt.Sub(bigInt.Mul(a.Y, b.Z), bigInt.Mul(a.Z, b.Y)),
-		Y: bigInt.Sub(bigInt.Mul(a.Z, b.X), bigInt.Mul(a.X, b.Z)),
-		Z: bigInt.Sub(bigInt.Mul(a.X, b.Y), bigInt.Mul(a.Y, b.X)),
+		X: a.Y.Mul(b.Z).Sub(a.Z.Mul(b.Y)),
+		Y: a.Z.Mul(b.X).Sub(a.X.Mul(b.Z)),
+		Z: a.X.Mul(b.Y).Sub(a.Y.Mul(b.X)),
 	}
 }
 
 func Minus(a Coord, b Coord) Coord {
 	return Coord{
-		X: bigInt.Sub(a.X, b.X),
-		Y: bigInt.Sub(a.Y, b.Y),
-		Z: bigInt.Sub(a.Z, b.Z),
+		X: a.X.Sub(b.X),
+		Y: a.Y.Sub(b.Y),
+		Z: a.Z.Sub(b.Z),
 	}
 }
 
 func Plus(a Coord, b Coord) Coord {
 	return Coord{
-		X: bigInt.Add(a.X, b.X),
-		Y: bigInt.Add(a.Y, b.Y),
-		Z: bigInt.Add(a.Z, b.Z),
+		X: a.X.Add(b.X),
+		Y: a.Y.Add(b.Y),
+		Z: a.Z.Add(b.Z),
 	}
 }
 
-func Multiply(a Coord, b *big.Int) Coord {
+func Multiply(a Coord, b bigInt.Int) Coord {
 	return Coord{
-		X: bigInt.Mul(a.X, b),
-		Y: bigInt.Mul(a.Y, b),
-		Z: bigInt.Mul(a.Z, b),
+		X: a.X.Mul(b),
+		Y: a.Y.Mul(b),
+		Z: a.Z.Mul(b),
 	}
 }
 
-func Divide(a Coord, b *big.Int) Coord {
+func Divide(a Coord, b bigInt.Int) Coord {
 	return Coord{
-		X: bigInt.Div(a.X, b),
-		Y: bigInt.Div(a.Y, b),
-		Z: bigInt.Div(a.Z, b),
+		X: a.X.Div(b),
+		Y: a.Y.Div(b),
+		Z: a.Z.Div(b),
 	}
 }
@@ -0,0 +1,36 @@
+package bigxyz
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/jmugliston/aoc/bigInt"
+)
+
+func TestCoord(t *testing.T) {
+	a := New(1, 2, 3)
+	b := New(4, 5, 6)
+
+	if Dot(a, b).MustInt() != 32 {
+		t.Errorf("Expected %v, got %v", 32, Dot(a, b))
+	}
+
+	if !Equal(Cross(a, b), New(-3, 6, -3)) {
+		t.Errorf("Expected %v, got %v", New(-3, 6, -3), Cross(a, b))
+	}
+
+	if !Equal(Divide(Multiply(Minus(b, a), bigInt.New(4)), bigInt.New(3)), New(4, 4, 4)) {
+		t.Errorf("Expected %v, got %v", New(4, 4, 4), Divide(Multiply(Minus(b, a), bigInt.New(4)), bigInt.New(3)))
+	}
+
+	// Values beyond an int
+	huge := Multiply(Plus(a, b), bigInt.New(10).Pow(20))
+
+	if fmt.Sprint(huge) != "500000000000000000000,700000000000000000000,900000000000000000000" {
+		t.Errorf("Unexpected %v", huge)
+	}
+
+	if !Equal(Coord{}, New(0, 0, 0)) {
+		t.Errorf("Expected the zero value to be the origin")
+	}
+}
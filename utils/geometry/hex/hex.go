@@ -0,0 +1,208 @@
+package hex
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Hex grid coordinates, see https://www.redblobgames.com/grids/hexagons/
+
+// Axial coordinates - q increases to the east and r to the south(east)
+type Axial struct {
+	Q int
+	R int
+}
+
+// Cube coordinates - always q + r + s = 0
+type Cube struct {
+	Q int
+	R int
+	S int
+}
+
+// Which way up the hexagons are drawn - only changes the names of the directions
+type Orientation int
+
+const (
+	// Neighbours to the E, NE, NW, W, SW and SE
+	PointyTop Orientation = iota
+	// Neighbours to the N, NE, SE, S, SW and NW
+	FlatTop
+)
+
+// One of the six neighbouring directions, counter-clockwise from +q
+type Direction int
+
+var directionVectors = [...]Axial{
+	{Q: 1, R: 0},
+	{Q: 1, R: -1},
+	{Q: 0, R: -1},
+	{Q: -1, R: 0},
+	{Q: -1, R: 1},
+	{Q: 0, R: 1},
+}
+
+var directionNames = map[Orientation][6]string{
+	PointyTop: {"e", "ne", "nw", "w", "sw", "se"},
+	FlatTop:   {"se", "ne", "n", "nw", "sw", "s"},
+}
+
+var Directions = [...]Direction{0, 1, 2, 3, 4, 5}
+
+func (d Direction) Vector() Axial {
+	return directionVectors[d]
+}
+
+func (d Direction) Opposite() Direction {
+	return (d + 3) % 6
+}
+
+// Turn 60 degrees clockwise
+func (d Direction) TurnRight() Direction {
+	return (d + 5) % 6
+}
+
+// Turn 60 degrees counter-clockwise
+func (d Direction) TurnLeft() Direction {
+	return (d + 1) % 6
+}
+
+func (d Direction) Name(o Orientation) string {
+	return directionNames[o][d]
+}
+
+func ParseDirection(name string, o Orientation) (Direction, error) {
+	for i, n := range directionNames[o] {
+		if n == strings.ToLower(name) {
+			return Direction(i), nil
+		}
+	}
+	return 0, fmt.Errorf("Invalid hex direction %q", name)
+}
+
+// A list of steps, either separated by commas ("ne,ne,s,s") or run together ("esenee")
+func ParseSteps(input string, o Orientation) ([]Direction, error) {
+	input = strings.ToLower(strings.TrimSpace(input))
+
+	var names []string
+	if strings.Contains(input, ",") {
+		for _, name := range strings.Split(input, ",") {
+			names = append(names, strings.TrimSpace(name))
+		}
+	} else {
+		// Two letter directions always start with n or s, so take the longest match
+		for i := 0; i < len(input); i++ {
+			if i+1 < len(input) && (input[i] == 'n' || input[i] == 's') {
+				if _, err := ParseDirection(input[i:i+2], o); err == nil {
+					names = append(names, input[i:i+2])
+					i++
+					continue
+				}
+			}
+			names = append(names, input[i:i+1])
+		}
+	}
+
+	steps := make([]Direction, 0, len(names))
+	for _, name := range names {
+		d, err := ParseDirection(name, o)
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, d)
+	}
+
+	return steps, nil
+}
+
+func (a Axial) Add(b Axial) Axial {
+	return Axial{Q: a.Q + b.Q, R: a.R + b.R}
+}
+
+func (a Axial) Sub(b Axial) Axial {
+	return Axial{Q: a.Q - b.Q, R: a.R - b.R}
+}
+
+func (a Axial) Scale(n int) Axial {
+	return Axial{Q: a.Q * n, R: a.R * n}
+}
+
+func (a Axial) ToCube() Cube {
+	return Cube{Q: a.Q, R: a.R, S: -a.Q - a.R}
+}
+
+func (a Axial) Neighbour(d Direction) Axial {
+	return a.Add(d.Vector())
+}
+
+// The six neighbours, in the same order as Directions
+func (a Axial) Neighbours() [6]Axial {
+	var neighbours [6]Axial
+	for i, d := range Directions {
+		neighbours[i] = a.Neighbour(d)
+	}
+	return neighbours
+}
+
+// Follow a list of steps
+func (a Axial) Walk(steps []Direction) Axial {
+	for _, d := range steps {
+		a = a.Neighbour(d)
+	}
+	return a
+}
+
+// Number of steps between two hexes
+func (a Axial) Distance(b Axial) int {
+	return a.ToCube().Distance(b.ToCube())
+}
+
+// The hexes exactly radius steps away, going counter-clockwise
+func (a Axial) Ring(radius int) []Axial {
+	if radius == 0 {
+		return []Axial{a}
+	}
+
+	ring := make([]Axial, 0, 6*radius)
+	current := a.Add(Direction(4).Vector().Scale(radius))
+	for _, d := range Directions {
+		for i := 0; i < radius; i++ {
+			ring = append(ring, current)
+			current = current.Neighbour(d)
+		}
+	}
+	return ring
+}
+
+// The hexes within radius steps, ring by ring from the centre
+func (a Axial) Spiral(radius int) []Axial {
+	spiral := []Axial{}
+	for r := 0; r <= radius; r++ {
+		spiral = append(spiral, a.Ring(r)...)
+	}
+	return spiral
+}
+
+func (c Cube) ToAxial() Axial {
+	return Axial{Q: c.Q, R: c.R}
+}
+
+func (c Cube) Add(d Cube) Cube {
+	return Cube{Q: c.Q + d.Q, R: c.R + d.R, S: c.S + d.S}
+}
+
+func (c Cube) Sub(d Cube) Cube {
+	return Cube{Q: c.Q - d.Q, R: c.R - d.R, S: c.S - d.S}
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+func (c Cube) Distance(d Cube) int {
+	diff := c.Sub(d)
+	return max(abs(diff.Q), abs(diff.R), abs(diff.S))
+}
@@ -0,0 +1,104 @@
+package hex
+
+import (
+	"testing"
+)
+
+func TestFlatTopSteps(t *testing.T) {
+	// 2017 day 11 - how far does the child process end up from the start?
+	tests := map[string]int{
+		"ne,ne,ne":       3,
+		"ne,ne,sw,sw":    0,
+		"ne,ne,s,s":      2,
+		"se,sw,se,sw,sw": 3,
+	}
+
+	for input, expected := range tests {
+		steps, err := ParseSteps(input, FlatTop)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		result := Axial{}.Walk(steps).Distance(Axial{})
+
+		if result != expected {
+			t.Errorf("%s: Expected %v, got %v", input, expected, result)
+		}
+	}
+}
+
+func TestPointyTopSteps(t *testing.T) {
+	// 2020 day 24 - tiles are identified by the steps from a reference tile
+	steps, err := ParseSteps("nwwswee", PointyTop)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(steps) != 5 || (Axial{}.Walk(steps) != Axial{}) {
+		t.Errorf("Expected to return to the start, got %v", Axial{}.Walk(steps))
+	}
+
+	steps, _ = ParseSteps("esew", PointyTop)
+	se, _ := ParseDirection("se", PointyTop)
+
+	if (Axial{}.Walk(steps) != Axial{}.Neighbour(se)) {
+		t.Errorf("Expected %v, got %v", Axial{}.Neighbour(se), Axial{}.Walk(steps))
+	}
+
+	if _, err := ParseSteps("ne,n", PointyTop); err == nil {
+		t.Errorf("Expected an error for a flat top direction")
+	}
+}
+
+func TestDirections(t *testing.T) {
+	for _, d := range Directions {
+		if d.Vector().Add(d.Opposite().Vector()) != (Axial{}) {
+			t.Errorf("Expected %v and its opposite to cancel out", d)
+		}
+		if d.TurnLeft().TurnRight() != d {
+			t.Errorf("Expected turning left then right to return to %v", d)
+		}
+		if d.Vector().ToCube().Distance(Cube{}) != 1 {
+			t.Errorf("Expected %v to be one step", d)
+		}
+	}
+
+	if Direction(2).Name(FlatTop) != "n" || Direction(2).Name(PointyTop) != "nw" {
+		t.Errorf("Unexpected names %v %v", Direction(2).Name(FlatTop), Direction(2).Name(PointyTop))
+	}
+}
+
+func TestRings(t *testing.T) {
+	centre := Axial{Q: 2, R: -1}
+
+	for radius := 0; radius < 5; radius++ {
+		ring := centre.Ring(radius)
+
+		expected := max(1, 6*radius)
+		if len(ring) != expected {
+			t.Errorf("Expected %v, got %v", expected, len(ring))
+		}
+
+		seen := map[Axial]bool{}
+		for _, h := range ring {
+			if h.Distance(centre) != radius {
+				t.Errorf("Expected %v to be %v from the centre", h, radius)
+			}
+			seen[h] = true
+		}
+
+		if len(seen) != len(ring) {
+			t.Errorf("Expected every hex in the ring to be different")
+		}
+	}
+
+	if len(centre.Spiral(3)) != 37 {
+		t.Errorf("Expected %v, got %v", 37, len(centre.Spiral(3)))
+	}
+
+	if (Cube{Q: 1, R: -3, S: 2}).ToAxial().ToCube() != (Cube{Q: 1, R: -3, S: 2}) {
+		t.Errorf("Expected cube coordinates to round trip")
+	}
+}
@@ -0,0 +1,86 @@
+package xyz
+
+// An axis-aligned box, with both corners included
+type Box struct {
+	Min Coord
+	Max Coord
+}
+
+// The box between two opposite corners (in any order)
+func NewBox(a Coord, b Coord) Box {
+	return Box{Min: Min(a, b), Max: Max(a, b)}
+}
+
+// The smallest box containing every coordinate
+func BoundingBox(coords ...Coord) Box {
+	if len(coords) == 0 {
+		return Box{}
+	}
+	box := Box{Min: coords[0], Max: coords[0]}
+	for _, c := range coords[1:] {
+		box = box.Expand(c)
+	}
+	return box
+}
+
+// The smallest box containing the box and c
+func (b Box) Expand(c Coord) Box {
+	return Box{Min: Min(b.Min, c), Max: Max(b.Max, c)}
+}
+
+// Grow (or shrink, for negative n) the box by n on every side
+func (b Box) Grow(n int) Box {
+	return Box{Min: Minus(b.Min, Coord{X: n, Y: n, Z: n}), Max: Plus(b.Max, Coord{X: n, Y: n, Z: n})}
+}
+
+func (b Box) Size() Coord {
+	return Plus(Minus(b.Max, b.Min), Coord{X: 1, Y: 1, Z: 1})
+}
+
+func (b Box) Volume() int {
+	size := b.Size()
+	return size.X * size.Y * size.Z
+}
+
+func (b Box) Contains(c Coord) bool {
+	return c.X >= b.Min.X && c.X <= b.Max.X &&
+		c.Y >= b.Min.Y && c.Y <= b.Max.Y &&
+		c.Z >= b.Min.Z && c.Z <= b.Max.Z
+}
+
+// Whether other lies completely inside the box
+func (b Box) ContainsBox(other Box) bool {
+	return b.Contains(other.Min) && b.Contains(other.Max)
+}
+
+func (b Box) Intersects(other Box) bool {
+	_, ok := b.Intersection(other)
+	return ok
+}
+
+// The overlap between two boxes, if they overlap at all
+func (b Box) Intersection(other Box) (Box, bool) {
+	overlap := Box{Min: Max(b.Min, other.Min), Max: Min(b.Max, other.Max)}
+	if overlap.Min.X > overlap.Max.X || overlap.Min.Y > overlap.Max.Y || overlap.Min.Z > overlap.Max.Z {
+		return Box{}, false
+	}
+	return overlap, true
+}
+
+// Move the box by an offset
+func (b Box) Shift(offset Coord) Box {
+	return Box{Min: Plus(b.Min, offset), Max: Plus(b.Max, offset)}
+}
+
+// Every coordinate in the box, ordered by z then y then x
+func (b Box) Coords() []Coord {
+	coords := make([]Coord, 0, b.Volume())
+	for z := b.Min.Z; z <= b.Max.Z; z++ {
+		for y := b.Min.Y; y <= b.Max.Y; y++ {
+			for x := b.Min.X; x <= b.Max.X; x++ {
+				coords = append(coords, Coord{X: x, Y: y, Z: z})
+			}
+		}
+	}
+	return coords
+}
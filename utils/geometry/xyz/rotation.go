@@ -0,0 +1,67 @@
+package xyz
+
+// A rotation matrix - each row picks one axis of the input, possibly negated
+type Rotation [3][3]int
+
+var Identity = Rotation{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}}
+
+func (r Rotation) Apply(c Coord) Coord {
+	return Coord{
+		X: r[0][0]*c.X + r[0][1]*c.Y + r[0][2]*c.Z,
+		Y: r[1][0]*c.X + r[1][1]*c.Y + r[1][2]*c.Z,
+		Z: r[2][0]*c.X + r[2][1]*c.Y + r[2][2]*c.Z,
+	}
+}
+
+// Rotate by other, then by r
+func (r Rotation) Compose(other Rotation) Rotation {
+	var result Rotation
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			for k := 0; k < 3; k++ {
+				result[i][j] += r[i][k] * other[k][j]
+			}
+		}
+	}
+	return result
+}
+
+// The rotation that undoes r (the transpose, as rotations are orthogonal)
+func (r Rotation) Inverse() Rotation {
+	var result Rotation
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			result[i][j] = r[j][i]
+		}
+	}
+	return result
+}
+
+func (r Rotation) determinant() int {
+	return r[0][0]*(r[1][1]*r[2][2]-r[1][2]*r[2][1]) -
+		r[0][1]*(r[1][0]*r[2][2]-r[1][2]*r[2][0]) +
+		r[0][2]*(r[1][0]*r[2][1]-r[1][1]*r[2][0])
+}
+
+// The 24 ways to orient a cube - every signed permutation of the axes
+// that doesn't mirror (the determinant is 1), starting with the identity
+var Rotations = func() []Rotation {
+	permutations := [][3]int{{0, 1, 2}, {0, 2, 1}, {1, 0, 2}, {1, 2, 0}, {2, 0, 1}, {2, 1, 0}}
+
+	rotations := []Rotation{}
+	for _, permutation := range permutations {
+		for signs := 0; signs < 8; signs++ {
+			var r Rotation
+			for row, axis := range permutation {
+				r[row][axis] = 1
+				if signs&(1<<row) != 0 {
+					r[row][axis] = -1
+				}
+			}
+			if r.determinant() == 1 {
+				rotations = append(rotations, r)
+			}
+		}
+	}
+	return rotations
+}()
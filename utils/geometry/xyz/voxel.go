@@ -0,0 +1,127 @@
+package xyz
+
+import (
+	"slices"
+)
+
+// An unbounded 3D grid - only coordinates that have been set are stored
+type VoxelGrid[T comparable] struct {
+	Default T
+	cells   map[Coord]T
+}
+
+func NewVoxelGrid[T comparable](defaultValue T) *VoxelGrid[T] {
+	return &VoxelGrid[T]{Default: defaultValue, cells: map[Coord]T{}}
+}
+
+func (g *VoxelGrid[T]) Get(c Coord) T {
+	if value, ok := g.cells[c]; ok {
+		return value
+	}
+	return g.Default
+}
+
+// Set a coordinate - setting the default value removes it
+func (g *VoxelGrid[T]) Set(c Coord, value T) {
+	if value == g.Default {
+		delete(g.cells, c)
+		return
+	}
+	g.cells[c] = value
+}
+
+func (g *VoxelGrid[T]) Delete(c Coord) {
+	delete(g.cells, c)
+}
+
+func (g *VoxelGrid[T]) Has(c Coord) bool {
+	_, ok := g.cells[c]
+	return ok
+}
+
+// Number of coordinates that have been set
+func (g *VoxelGrid[T]) Len() int {
+	return len(g.cells)
+}
+
+// Coordinates that have been set, ordered by z then y then x
+func (g *VoxelGrid[T]) Coords() []Coord {
+	coords := make([]Coord, 0, len(g.cells))
+	for c := range g.cells {
+		coords = append(coords, c)
+	}
+	slices.SortFunc(coords, func(a, b Coord) int {
+		if a.Z != b.Z {
+			return a.Z - b.Z
+		}
+		if a.Y != b.Y {
+			return a.Y - b.Y
+		}
+		return a.X - b.X
+	})
+	return coords
+}
+
+// The smallest box containing every coordinate that has been set
+func (g *VoxelGrid[T]) Bounds() Box {
+	coords := make([]Coord, 0, len(g.cells))
+	for c := range g.cells {
+		coords = append(coords, c)
+	}
+	return BoundingBox(coords...)
+}
+
+// Number of faces of set voxels that don't touch another set voxel
+func (g *VoxelGrid[T]) SurfaceArea() int {
+	area := 0
+	for c := range g.cells {
+		for _, neighbour := range Neighbours(c) {
+			if !g.Has(neighbour) {
+				area++
+			}
+		}
+	}
+	return area
+}
+
+// The empty coordinates reachable from outside the grid (within one step of its bounds)
+func (g *VoxelGrid[T]) Exterior() map[Coord]bool {
+	exterior := map[Coord]bool{}
+	if len(g.cells) == 0 {
+		return exterior
+	}
+
+	bounds := g.Bounds().Grow(1)
+
+	queue := []Coord{bounds.Min}
+	exterior[bounds.Min] = true
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for _, neighbour := range Neighbours(current) {
+			if exterior[neighbour] || !bounds.Contains(neighbour) || g.Has(neighbour) {
+				continue
+			}
+			exterior[neighbour] = true
+			queue = append(queue, neighbour)
+		}
+	}
+
+	return exterior
+}
+
+// Number of faces of set voxels that can be reached from outside (ignoring enclosed air pockets)
+func (g *VoxelGrid[T]) ExteriorSurfaceArea() int {
+	exterior := g.Exterior()
+	area := 0
+	for c := range g.cells {
+		for _, neighbour := range Neighbours(c) {
+			if exterior[neighbour] {
+				area++
+			}
+		}
+	}
+	return area
+}
@@ -49,3 +49,64 @@ func Divide(a Coord, b int) Coord {
 		Z: a.Z / b,
 	}
 }
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+func Manhattan(a Coord, b Coord) int {
+	return abs(a.X-b.X) + abs(a.Y-b.Y) + abs(a.Z-b.Z)
+}
+
+// The coordinate with the smallest value on each axis
+func Min(a Coord, b Coord) Coord {
+	return Coord{X: min(a.X, b.X), Y: min(a.Y, b.Y), Z: min(a.Z, b.Z)}
+}
+
+// The coordinate with the largest value on each axis
+func Max(a Coord, b Coord) Coord {
+	return Coord{X: max(a.X, b.X), Y: max(a.Y, b.Y), Z: max(a.Z, b.Z)}
+}
+
+// Unit steps to the six faces of a cube
+var FaceDirections = []Coord{
+	{X: 1}, {X: -1},
+	{Y: 1}, {Y: -1},
+	{Z: 1}, {Z: -1},
+}
+
+// Unit steps to every cube touching a cube (faces, edges and corners)
+var AllDirections = func() []Coord {
+	directions := []Coord{}
+	for x := -1; x <= 1; x++ {
+		for y := -1; y <= 1; y++ {
+			for z := -1; z <= 1; z++ {
+				if x != 0 || y != 0 || z != 0 {
+					directions = append(directions, Coord{X: x, Y: y, Z: z})
+				}
+			}
+		}
+	}
+	return directions
+}()
+
+// The six coordinates sharing a face with c
+func Neighbours(c Coord) []Coord {
+	neighbours := make([]Coord, 0, len(FaceDirections))
+	for _, d := range FaceDirections {
+		neighbours = append(neighbours, Plus(c, d))
+	}
+	return neighbours
+}
+
+// The 26 coordinates sharing a face, edge or corner with c
+func AllNeighbours(c Coord) []Coord {
+	neighbours := make([]Coord, 0, len(AllDirections))
+	for _, d := range AllDirections {
+		neighbours = append(neighbours, Plus(c, d))
+	}
+	return neighbours
+}
@@ -0,0 +1,118 @@
+package xyz
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestNeighbours(t *testing.T) {
+	c := Coord{X: 1, Y: 2, Z: 3}
+
+	if len(Neighbours(c)) != 6 || len(AllNeighbours(c)) != 26 {
+		t.Errorf("Expected %v and %v, got %v and %v", 6, 26, len(Neighbours(c)), len(AllNeighbours(c)))
+	}
+
+	for _, n := range Neighbours(c) {
+		if Manhattan(c, n) != 1 {
+			t.Errorf("Expected %v to be next to %v", n, c)
+		}
+	}
+
+	if Manhattan(Coord{X: -1, Y: 2, Z: -3}, Coord{X: 2, Y: -2, Z: 0}) != 10 {
+		t.Errorf("Expected %v, got %v", 10, Manhattan(Coord{X: -1, Y: 2, Z: -3}, Coord{X: 2, Y: -2, Z: 0}))
+	}
+}
+
+func TestBox(t *testing.T) {
+	// 2023 day 22 - bricks are boxes between two corners
+	a := NewBox(Coord{X: 0, Y: 0, Z: 2}, Coord{X: 2, Y: 0, Z: 2})
+	b := NewBox(Coord{X: 1, Y: 2, Z: 1}, Coord{X: 1, Y: 0, Z: 1})
+
+	if a.Volume() != 3 || len(b.Coords()) != 3 {
+		t.Errorf("Expected %v, got %v and %v", 3, a.Volume(), len(b.Coords()))
+	}
+
+	if a.Intersects(b) {
+		t.Errorf("Expected %v and %v not to intersect", a, b)
+	}
+
+	overlap, ok := a.Shift(Coord{Z: -1}).Intersection(b)
+
+	if !ok || overlap != NewBox(Coord{X: 1, Y: 0, Z: 1}, Coord{X: 1, Y: 0, Z: 1}) {
+		t.Errorf("Expected a single cube overlap, got %v", overlap)
+	}
+
+	bounds := BoundingBox(a.Min, a.Max, b.Min, b.Max)
+
+	if !bounds.ContainsBox(a) || !bounds.ContainsBox(b) || bounds.ContainsBox(bounds.Grow(1)) {
+		t.Errorf("Unexpected bounding box %v", bounds)
+	}
+}
+
+func TestRotations(t *testing.T) {
+	if len(Rotations) != 24 || Rotations[0] != Identity {
+		t.Fatalf("Expected %v rotations, got %v", 24, len(Rotations))
+	}
+
+	c := Coord{X: 1, Y: 2, Z: 3}
+	seen := map[Coord]bool{}
+
+	for _, r := range Rotations {
+		rotated := r.Apply(c)
+		seen[rotated] = true
+
+		if r.Inverse().Apply(rotated) != c {
+			t.Errorf("Expected the inverse of %v to undo it", r)
+		}
+
+		if Dot(rotated, rotated) != Dot(c, c) {
+			t.Errorf("Expected %v to keep its length", rotated)
+		}
+
+		// The right hand rule still holds
+		x, y, z := r.Apply(Coord{X: 1}), r.Apply(Coord{Y: 1}), r.Apply(Coord{Z: 1})
+		if Cross(x, y) != z {
+			t.Errorf("Expected %v to not be a reflection", r)
+		}
+	}
+
+	if len(seen) != 24 {
+		t.Errorf("Expected %v, got %v", 24, len(seen))
+	}
+
+	quarterTurn := Rotation{{0, -1, 0}, {1, 0, 0}, {0, 0, 1}}
+	if quarterTurn.Compose(quarterTurn).Compose(quarterTurn.Compose(quarterTurn)) != Identity {
+		t.Errorf("Expected four quarter turns to be the identity")
+	}
+}
+
+func TestSurfaceArea(t *testing.T) {
+	// 2022 day 18 - the lava droplet example
+	input := `2,2,2 1,2,2 3,2,2 2,1,2 2,3,2 2,2,1 2,2,3 2,2,4 2,2,6 1,2,5 3,2,5 2,1,5 2,3,5`
+
+	g := NewVoxelGrid(false)
+	for _, cube := range strings.Fields(input) {
+		var c Coord
+		fmt.Sscanf(cube, "%d,%d,%d", &c.X, &c.Y, &c.Z)
+		g.Set(c, true)
+	}
+
+	if g.SurfaceArea() != 64 {
+		t.Errorf("Expected %v, got %v", 64, g.SurfaceArea())
+	}
+
+	if g.ExteriorSurfaceArea() != 58 {
+		t.Errorf("Expected %v, got %v", 58, g.ExteriorSurfaceArea())
+	}
+
+	if g.Bounds() != NewBox(Coord{X: 1, Y: 1, Z: 1}, Coord{X: 3, Y: 3, Z: 6}) {
+		t.Errorf("Unexpected bounds %v", g.Bounds())
+	}
+
+	g.Set(Coord{X: 2, Y: 2, Z: 2}, false)
+
+	if g.Len() != 12 || g.Has(Coord{X: 2, Y: 2, Z: 2}) {
+		t.Errorf("Expected setting the default value to remove the cube")
+	}
+}
@@ -0,0 +1,467 @@
+package graph
+
+import (
+	"container/heap"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+)
+
+// Outgoing edges for each node, keyed by node name
+func (g *Graph) adjacency() map[string][]*Edge {
+	adj := make(map[string][]*Edge, len(g.Nodes))
+	for _, edge := range g.Edges {
+		adj[edge.Source] = append(adj[edge.Source], edge)
+	}
+	return adj
+}
+
+// Neighbour sets for each node, ignoring edge direction and self loops
+func (g *Graph) undirectedAdjacency() map[string]map[string]bool {
+	adj := make(map[string]map[string]bool, len(g.Nodes))
+	for _, node := range g.Nodes {
+		adj[node.Name] = map[string]bool{}
+	}
+	for _, edge := range g.Edges {
+		if edge.Source == edge.Target {
+			continue
+		}
+		if adj[edge.Source] == nil {
+			adj[edge.Source] = map[string]bool{}
+		}
+		if adj[edge.Target] == nil {
+			adj[edge.Target] = map[string]bool{}
+		}
+		adj[edge.Source][edge.Target] = true
+		adj[edge.Target][edge.Source] = true
+	}
+	return adj
+}
+
+// Names of the nodes reachable from n by following a single edge
+func (g *Graph) Neighbours(n string) []string {
+	neighbours := []string{}
+	for _, edge := range g.Edges {
+		if edge.Source == n {
+			neighbours = append(neighbours, edge.Target)
+		}
+	}
+	return neighbours
+}
+
+// Groups of nodes connected to each other, ignoring edge direction
+// Components are returned in the order their first node appears in the graph
+func (g *Graph) ConnectedComponents() [][]string {
+	adj := g.undirectedAdjacency()
+
+	visited := map[string]bool{}
+	components := [][]string{}
+
+	for _, node := range g.Nodes {
+		if visited[node.Name] {
+			continue
+		}
+
+		component := []string{}
+		stack := []string{node.Name}
+		visited[node.Name] = true
+
+		for len(stack) > 0 {
+			current := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			component = append(component, current)
+
+			for next := range adj[current] {
+				if !visited[next] {
+					visited[next] = true
+					stack = append(stack, next)
+				}
+			}
+		}
+
+		slices.Sort(component)
+		components = append(components, component)
+	}
+
+	return components
+}
+
+// Kahn's algorithm for ordering the nodes so every edge points forwards
+// https://en.wikipedia.org/wiki/Topological_sorting
+// Returns an error describing a cycle if the graph is not a DAG
+func (g *Graph) TopologicalSort() ([]string, error) {
+	inDegree := make(map[string]int, len(g.Nodes))
+	for _, node := range g.Nodes {
+		inDegree[node.Name] = 0
+	}
+	for _, edge := range g.Edges {
+		inDegree[edge.Target]++
+	}
+
+	adj := g.adjacency()
+
+	queue := []string{}
+	for _, node := range g.Nodes {
+		if inDegree[node.Name] == 0 {
+			queue = append(queue, node.Name)
+		}
+	}
+
+	sorted := make([]string, 0, len(g.Nodes))
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		sorted = append(sorted, current)
+
+		for _, edge := range adj[current] {
+			inDegree[edge.Target]--
+			if inDegree[edge.Target] == 0 {
+				queue = append(queue, edge.Target)
+			}
+		}
+	}
+
+	if len(sorted) != len(g.Nodes) {
+		return nil, fmt.Errorf("Graph contains a cycle: %s", strings.Join(g.FindCycle(), " -> "))
+	}
+
+	return sorted, nil
+}
+
+// A directed cycle in the graph (the first node is repeated at the end)
+// Returns nil if the graph is acyclic
+func (g *Graph) FindCycle() []string {
+	const (
+		unvisited = iota
+		inProgress
+		done
+	)
+
+	adj := g.adjacency()
+	state := map[string]int{}
+	parent := map[string]string{}
+
+	var cycle []string
+
+	var visit func(n string) bool
+	visit = func(n string) bool {
+		state[n] = inProgress
+		for _, edge := range adj[n] {
+			switch state[edge.Target] {
+			case unvisited:
+				parent[edge.Target] = n
+				if visit(edge.Target) {
+					return true
+				}
+			case inProgress:
+				// Walk back up the DFS stack to recover the cycle
+				cycle = []string{edge.Target}
+				for current := n; current != edge.Target; current = parent[current] {
+					cycle = append(cycle, current)
+				}
+				cycle = append(cycle, edge.Target)
+				slices.Reverse(cycle)
+				return true
+			}
+		}
+		state[n] = done
+		return false
+	}
+
+	for _, node := range g.Nodes {
+		if state[node.Name] == unvisited && visit(node.Name) {
+			return cycle
+		}
+	}
+
+	return nil
+}
+
+// Bron–Kerbosch algorithm (with pivoting) for finding every maximal clique
+// https://en.wikipedia.org/wiki/Bron%E2%80%93Kerbosch_algorithm
+// Edge direction is ignored and each clique is sorted by node name
+func (g *Graph) MaximalCliques() [][]string {
+	adj := g.undirectedAdjacency()
+
+	cliques := [][]string{}
+
+	var bronKerbosch func(r []string, p, x map[string]bool)
+	bronKerbosch = func(r []string, p, x map[string]bool) {
+		if len(p) == 0 && len(x) == 0 {
+			clique := slices.Clone(r)
+			slices.Sort(clique)
+			cliques = append(cliques, clique)
+			return
+		}
+
+		// Choose the pivot with the most neighbours in p to minimise branching
+		pivot, best := "", -1
+		for _, set := range []map[string]bool{p, x} {
+			for u := range set {
+				count := 0
+				for v := range p {
+					if adj[u][v] {
+						count++
+					}
+				}
+				if count > best {
+					pivot, best = u, count
+				}
+			}
+		}
+
+		candidates := []string{}
+		for v := range p {
+			if !adj[pivot][v] {
+				candidates = append(candidates, v)
+			}
+		}
+
+		for _, v := range candidates {
+			nextP := map[string]bool{}
+			nextX := map[string]bool{}
+			for u := range adj[v] {
+				if p[u] {
+					nextP[u] = true
+				}
+				if x[u] {
+					nextX[u] = true
+				}
+			}
+
+			bronKerbosch(append(r, v), nextP, nextX)
+
+			delete(p, v)
+			x[v] = true
+		}
+	}
+
+	p := map[string]bool{}
+	for _, node := range g.Nodes {
+		p[node.Name] = true
+	}
+
+	bronKerbosch([]string{}, p, map[string]bool{})
+
+	return cliques
+}
+
+// The largest clique in the graph, ties are broken alphabetically
+func (g *Graph) MaximumClique() []string {
+	largest := []string{}
+	for _, clique := range g.MaximalCliques() {
+		if len(clique) > len(largest) ||
+			(len(clique) == len(largest) && slices.Compare(clique, largest) < 0) {
+			largest = clique
+		}
+	}
+	return largest
+}
+
+type weightedNode struct {
+	index  int
+	weight int
+}
+
+type weightedQueue []weightedNode
+
+func (q weightedQueue) Len() int           { return len(q) }
+func (q weightedQueue) Less(i, j int) bool { return q[i].weight > q[j].weight }
+func (q weightedQueue) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q *weightedQueue) Push(x any)        { *q = append(*q, x.(weightedNode)) }
+func (q *weightedQueue) Pop() any {
+	old := *q
+	n := len(old)
+	item := old[n-1]
+	*q = old[:n-1]
+	return item
+}
+
+// Stoer–Wagner algorithm for finding the global minimum cut in a graph
+// https://en.wikipedia.org/wiki/Stoer%E2%80%93Wagner_algorithm
+// Edge direction is ignored and each connected pair of nodes has a weight of 1
+// Returns the edges that were cut, and the two partitions
+func (g *Graph) MinCut() ([]*Edge, [][]string) {
+	if len(g.Nodes) < 2 {
+		return []*Edge{}, [][]string{}
+	}
+
+	index := make(map[string]int, len(g.Nodes))
+	groups := make([][]string, len(g.Nodes))
+	for i, node := range g.Nodes {
+		index[node.Name] = i
+		groups[i] = []string{node.Name}
+	}
+
+	weights := make([]map[int]int, len(g.Nodes))
+	for i := range weights {
+		weights[i] = map[int]int{}
+	}
+	for source, targets := range g.undirectedAdjacency() {
+		for target := range targets {
+			weights[index[source]][index[target]] = 1
+		}
+	}
+
+	active := make([]bool, len(g.Nodes))
+	for i := range active {
+		active[i] = true
+	}
+
+	bestWeight := math.MaxInt
+	var bestGroup []string
+
+	for remaining := len(g.Nodes); remaining > 1; remaining-- {
+		// Minimum cut phase - grow a set by repeatedly adding the most tightly connected node
+		added := make([]bool, len(g.Nodes))
+		connectivity := make([]int, len(g.Nodes))
+
+		queue := &weightedQueue{}
+		for i, isActive := range active {
+			if isActive {
+				heap.Push(queue, weightedNode{index: i, weight: 0})
+			}
+		}
+
+		previous, last := -1, -1
+
+		for queue.Len() > 0 {
+			current := heap.Pop(queue).(weightedNode)
+			if added[current.index] || current.weight != connectivity[current.index] {
+				continue
+			}
+
+			added[current.index] = true
+			previous, last = last, current.index
+
+			for next, weight := range weights[current.index] {
+				if !added[next] {
+					connectivity[next] += weight
+					heap.Push(queue, weightedNode{index: next, weight: connectivity[next]})
+				}
+			}
+		}
+
+		if connectivity[last] < bestWeight {
+			bestWeight = connectivity[last]
+			bestGroup = slices.Clone(groups[last])
+		}
+
+		// Merge the last node added into the one added before it
+		for next, weight := range weights[last] {
+			delete(weights[next], last)
+			if next != previous {
+				weights[previous][next] += weight
+				weights[next][previous] += weight
+			}
+		}
+		weights[last] = nil
+		groups[previous] = append(groups[previous], groups[last]...)
+		active[last] = false
+	}
+
+	inGroup := map[string]bool{}
+	for _, name := range bestGroup {
+		inGroup[name] = true
+	}
+
+	partitions := make([][]string, 2)
+	for _, node := range g.Nodes {
+		if inGroup[node.Name] {
+			partitions[0] = append(partitions[0], node.Name)
+		} else {
+			partitions[1] = append(partitions[1], node.Name)
+		}
+	}
+
+	cuts := make([]*Edge, 0)
+	seen := map[[2]string]bool{}
+	for _, edge := range g.Edges {
+		if inGroup[edge.Source] == inGroup[edge.Target] {
+			continue
+		}
+		key := [2]string{edge.Source, edge.Target}
+		if key[0] > key[1] {
+			key[0], key[1] = key[1], key[0]
+		}
+		if !seen[key] {
+			seen[key] = true
+			cuts = append(cuts, edge)
+		}
+	}
+
+	return cuts, partitions
+}
+
+// Dijkstra's algorithm for the distance from source to every reachable node
+// https://en.wikipedia.org/wiki/Dijkstra%27s_algorithm
+// A nil weight function treats every edge as having a weight of 1
+// Returns the distances and the previous node on each shortest path
+func (g *Graph) ShortestPaths(source string, weight func(*Edge) int) (map[string]int, map[string]string) {
+	if weight == nil {
+		weight = func(*Edge) int { return 1 }
+	}
+
+	adj := g.adjacency()
+
+	index := map[string]int{}
+	names := []string{}
+	indexOf := func(name string) int {
+		if i, ok := index[name]; ok {
+			return i
+		}
+		index[name] = len(names)
+		names = append(names, name)
+		return index[name]
+	}
+
+	distances := map[string]int{source: 0}
+	previous := map[string]string{}
+	visited := map[string]bool{}
+
+	// Reuse the max-heap by negating the distances
+	queue := &weightedQueue{}
+	heap.Push(queue, weightedNode{index: indexOf(source), weight: 0})
+
+	for queue.Len() > 0 {
+		current := heap.Pop(queue).(weightedNode)
+		name := names[current.index]
+
+		if visited[name] {
+			continue
+		}
+		visited[name] = true
+
+		for _, edge := range adj[name] {
+			distance := distances[name] + weight(edge)
+			if existing, ok := distances[edge.Target]; !ok || distance < existing {
+				distances[edge.Target] = distance
+				previous[edge.Target] = name
+				heap.Push(queue, weightedNode{index: indexOf(edge.Target), weight: -distance})
+			}
+		}
+	}
+
+	return distances, previous
+}
+
+// The shortest path from source to target (inclusive) and its total weight
+func (g *Graph) ShortestPath(source, target string, weight func(*Edge) int) ([]string, int, error) {
+	distances, previous := g.ShortestPaths(source, weight)
+
+	distance, ok := distances[target]
+	if !ok {
+		return nil, 0, fmt.Errorf("No path from %s to %s", source, target)
+	}
+
+	path := []string{target}
+	for current := target; current != source; {
+		current = previous[current]
+		path = append(path, current)
+	}
+	slices.Reverse(path)
+
+	return path, distance, nil
+}
@@ -0,0 +1,235 @@
+package graph
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func readExample(path string) string {
+	input, err := os.ReadFile(path)
+
+	if err != nil {
+		panic("Couldn't find the example file!")
+	}
+
+	return strings.TrimSpace(string(input))
+}
+
+// 2023 day 25 - "jqt: rhn xhk nvd"
+func parseComponents(input string) Graph {
+	g := Graph{}
+	for _, line := range strings.Split(input, "\n") {
+		split := strings.Split(line, ":")
+		g.AddNode(split[0])
+		for _, dest := range strings.Fields(split[1]) {
+			g.AddNode(dest)
+			g.AddEdge(split[0]+"-"+dest, split[0], dest, []string{})
+		}
+	}
+	return g
+}
+
+// 2024 day 23 - "kh-tc"
+func parseNetwork(input string) Graph {
+	g := Graph{}
+	for _, line := range strings.Split(input, "\n") {
+		split := strings.Split(line, "-")
+		g.AddNode(split[0])
+		g.AddNode(split[1])
+		g.AddEdge(line, split[0], split[1], []string{})
+		g.AddEdge(split[1]+"-"+split[0], split[1], split[0], []string{})
+	}
+	return g
+}
+
+func TestMinCut(t *testing.T) {
+	g := parseComponents(readExample("../../2023/day25/input/example.txt"))
+
+	cuts, partitions := g.MinCut()
+
+	if len(cuts) != 3 {
+		t.Errorf("Expected %v, got %v", 3, len(cuts))
+	}
+
+	expected := 54
+	result := len(partitions[0]) * len(partitions[1])
+
+	if result != expected {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestConnectedComponents(t *testing.T) {
+	g := parseComponents(readExample("../../2023/day25/input/example.txt"))
+
+	if len(g.ConnectedComponents()) != 1 {
+		t.Errorf("Expected %v, got %v", 1, len(g.ConnectedComponents()))
+	}
+
+	cuts, _ := g.MinCut()
+	for _, edge := range cuts {
+		g.RemoveEdge(edge.Source, edge.Target)
+	}
+
+	sizes := []int{}
+	for _, component := range g.ConnectedComponents() {
+		sizes = append(sizes, len(component))
+	}
+	slices.Sort(sizes)
+
+	expected := []int{6, 9}
+
+	if !slices.Equal(sizes, expected) {
+		t.Errorf("Expected %v, got %v", expected, sizes)
+	}
+}
+
+func TestMaximalCliques(t *testing.T) {
+	g := parseNetwork(readExample("../../2024/day23/input/example.txt"))
+
+	// Every triangle is part of some maximal clique of size 3 or more
+	triangles := map[string]bool{}
+	for _, clique := range g.MaximalCliques() {
+		for i := 0; i < len(clique); i++ {
+			for j := i + 1; j < len(clique); j++ {
+				for k := j + 1; k < len(clique); k++ {
+					triangle := []string{clique[i], clique[j], clique[k]}
+					if slices.ContainsFunc(triangle, func(n string) bool { return strings.HasPrefix(n, "t") }) {
+						triangles[strings.Join(triangle, ",")] = true
+					}
+				}
+			}
+		}
+	}
+
+	if len(triangles) != 7 {
+		t.Errorf("Expected %v, got %v", 7, len(triangles))
+	}
+}
+
+func TestMaximumClique(t *testing.T) {
+	g := parseNetwork(readExample("../../2024/day23/input/example.txt"))
+
+	expected := "co,de,ka,ta"
+	result := strings.Join(g.MaximumClique(), ",")
+
+	if result != expected {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestTopologicalSort(t *testing.T) {
+	sections := strings.Split(readExample("../../2024/day05/input/example.txt"), "\n\n")
+	rules := strings.Split(sections[0], "\n")
+
+	part1, part2 := 0, 0
+
+	for _, update := range strings.Split(sections[1], "\n") {
+		pages := strings.Split(update, ",")
+
+		// Only the rules between pages in this update apply
+		g := Graph{}
+		for _, page := range pages {
+			g.AddNode(page)
+		}
+		for _, rule := range rules {
+			split := strings.Split(rule, "|")
+			if slices.Contains(pages, split[0]) && slices.Contains(pages, split[1]) {
+				g.AddEdge(rule, split[0], split[1], []string{})
+			}
+		}
+
+		sorted, err := g.TopologicalSort()
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		middle, _ := strconv.Atoi(sorted[len(sorted)/2])
+
+		if slices.Equal(sorted, pages) {
+			part1 += middle
+		} else {
+			part2 += middle
+		}
+	}
+
+	if part1 != 143 {
+		t.Errorf("Expected %v, got %v", 143, part1)
+	}
+
+	if part2 != 123 {
+		t.Errorf("Expected %v, got %v", 123, part2)
+	}
+}
+
+func TestTopologicalSortCycle(t *testing.T) {
+	g := Graph{}
+	for _, n := range []string{"a", "b", "c", "d"} {
+		g.AddNode(n)
+	}
+	g.AddEdge("a-b", "a", "b", []string{})
+	g.AddEdge("b-c", "b", "c", []string{})
+	g.AddEdge("c-d", "c", "d", []string{})
+	g.AddEdge("d-b", "d", "b", []string{})
+
+	_, err := g.TopologicalSort()
+
+	if err == nil {
+		t.Errorf("Expected an error for a cyclic graph")
+	}
+
+	expected := []string{"b", "c", "d", "b"}
+	result := g.FindCycle()
+
+	if !slices.Equal(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestShortestPath(t *testing.T) {
+	// 2024 day 18 - the first 12 bytes fall on a 7x7 grid
+	corrupted := map[string]bool{}
+	for i, line := range strings.Split(readExample("../../2024/day18/input/example.txt"), "\n") {
+		if i < 12 {
+			corrupted[line] = true
+		}
+	}
+
+	g := Graph{}
+	for y := 0; y < 7; y++ {
+		for x := 0; x < 7; x++ {
+			if !corrupted[fmt.Sprintf("%d,%d", x, y)] {
+				g.AddNode(fmt.Sprintf("%d,%d", x, y))
+			}
+		}
+	}
+	for _, node := range g.Nodes {
+		var x, y int
+		fmt.Sscanf(node.Name, "%d,%d", &x, &y)
+		for _, d := range [][2]int{{0, 1}, {1, 0}, {0, -1}, {-1, 0}} {
+			next := fmt.Sprintf("%d,%d", x+d[0], y+d[1])
+			g.AddEdge(node.Name+"-"+next, node.Name, next, []string{})
+		}
+	}
+
+	path, distance, err := g.ShortestPath("0,0", "6,6", nil)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if distance != 22 || len(path) != 23 {
+		t.Errorf("Expected %v, got %v", 22, distance)
+	}
+
+	_, _, err = g.ShortestPath("0,0", "7,7", nil)
+
+	if err == nil {
+		t.Errorf("Expected an error for an unreachable node")
+	}
+}
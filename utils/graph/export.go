@@ -0,0 +1,156 @@
+package graph
+
+import (
+	"fmt"
+	"strings"
+)
+
+type ExportOptions struct {
+	// Undirected graphs only draw one line for each connected pair of nodes
+	Undirected bool
+	// Node names to draw with a highlight
+	HighlightNodes map[string]bool
+	// Source/target pairs to draw with a highlight
+	HighlightEdges map[[2]string]bool
+}
+
+func (o ExportOptions) isEdgeHighlighted(edge *Edge) bool {
+	if o.HighlightEdges[[2]string{edge.Source, edge.Target}] {
+		return true
+	}
+	return o.Undirected && o.HighlightEdges[[2]string{edge.Target, edge.Source}]
+}
+
+// Edges to draw, dropping the reverse of any pair already seen for undirected graphs
+func (g *Graph) exportEdges(options ExportOptions) []*Edge {
+	if !options.Undirected {
+		return g.Edges
+	}
+
+	edges := []*Edge{}
+	seen := map[[2]string]bool{}
+	for _, edge := range g.Edges {
+		if seen[[2]string{edge.Target, edge.Source}] || seen[[2]string{edge.Source, edge.Target}] {
+			continue
+		}
+		seen[[2]string{edge.Source, edge.Target}] = true
+		edges = append(edges, edge)
+	}
+	return edges
+}
+
+// Export the graph as text in the given format ("dot" or "mermaid")
+func (g *Graph) Export(format string, options ExportOptions) (string, error) {
+	switch format {
+	case "dot":
+		return g.ToDOT(options), nil
+	case "mermaid":
+		return g.ToMermaid(options), nil
+	}
+	return "", fmt.Errorf("Unknown graph format: %s", format)
+}
+
+// Graphviz DOT language - https://graphviz.org/doc/info/lang.html
+// Node labels include the node data, and edge labels are the edge data
+func (g *Graph) ToDOT(options ExportOptions) string {
+	var output strings.Builder
+
+	graphType, connector := "digraph", "->"
+	if options.Undirected {
+		graphType, connector = "graph", "--"
+	}
+
+	output.WriteString(fmt.Sprintf("%s {\n", graphType))
+
+	for _, node := range g.Nodes {
+		label := strings.Join(append([]string{node.Name}, node.Data...), "\n")
+		attributes := []string{fmt.Sprintf("label=%s", dotQuote(label))}
+		if options.HighlightNodes[node.Name] {
+			attributes = append(attributes, `style=filled`, `fillcolor="#ffcc66"`)
+		}
+		output.WriteString(fmt.Sprintf("  %s [%s];\n", dotQuote(node.Name), strings.Join(attributes, ", ")))
+	}
+
+	for _, edge := range g.exportEdges(options) {
+		attributes := []string{}
+		if len(edge.Data) > 0 {
+			attributes = append(attributes, fmt.Sprintf("label=%s", dotQuote(strings.Join(edge.Data, ", "))))
+		}
+		if options.isEdgeHighlighted(edge) {
+			attributes = append(attributes, `color="#ff6600"`, `penwidth=2`)
+		}
+		output.WriteString(fmt.Sprintf("  %s %s %s", dotQuote(edge.Source), connector, dotQuote(edge.Target)))
+		if len(attributes) > 0 {
+			output.WriteString(fmt.Sprintf(" [%s]", strings.Join(attributes, ", ")))
+		}
+		output.WriteString(";\n")
+	}
+
+	output.WriteString("}\n")
+
+	return output.String()
+}
+
+func dotQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	return `"` + s + `"`
+}
+
+// Mermaid flowchart - https://mermaid.js.org/syntax/flowchart.html
+// Nodes are given generated ids so any node name can be used as a label
+func (g *Graph) ToMermaid(options ExportOptions) string {
+	var output strings.Builder
+
+	output.WriteString("flowchart LR\n")
+
+	ids := map[string]string{}
+	id := func(name string) string {
+		if _, ok := ids[name]; !ok {
+			ids[name] = fmt.Sprintf("n%d", len(ids))
+		}
+		return ids[name]
+	}
+
+	highlighted := []string{}
+	for _, node := range g.Nodes {
+		label := strings.Join(append([]string{node.Name}, node.Data...), "<br/>")
+		output.WriteString(fmt.Sprintf("  %s[\"%s\"]\n", id(node.Name), mermaidEscape(label)))
+		if options.HighlightNodes[node.Name] {
+			highlighted = append(highlighted, id(node.Name))
+		}
+	}
+
+	connector := "-->"
+	if options.Undirected {
+		connector = "---"
+	}
+
+	highlightedEdges := []string{}
+	for i, edge := range g.exportEdges(options) {
+		link := connector
+		if len(edge.Data) > 0 {
+			link = fmt.Sprintf("%s|\"%s\"|", connector, mermaidEscape(strings.Join(edge.Data, ", ")))
+		}
+		output.WriteString(fmt.Sprintf("  %s %s %s\n", id(edge.Source), link, id(edge.Target)))
+		if options.isEdgeHighlighted(edge) {
+			highlightedEdges = append(highlightedEdges, fmt.Sprint(i))
+		}
+	}
+
+	if len(highlighted) > 0 {
+		output.WriteString("  classDef highlight fill:#ffcc66\n")
+		output.WriteString(fmt.Sprintf("  class %s highlight\n", strings.Join(highlighted, ",")))
+	}
+
+	if len(highlightedEdges) > 0 {
+		output.WriteString(fmt.Sprintf("  linkStyle %s stroke:#ff6600,stroke-width:2px\n", strings.Join(highlightedEdges, ",")))
+	}
+
+	return output.String()
+}
+
+func mermaidEscape(s string) string {
+	return strings.ReplaceAll(s, `"`, "#quot;")
+}
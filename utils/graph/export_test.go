@@ -0,0 +1,75 @@
+package graph
+
+import (
+	"testing"
+)
+
+func exampleExportGraph() Graph {
+	g := Graph{}
+	g.AddNode("broadcaster")
+	g.AddNode("a")
+	g.AddNode("inv")
+	a, _ := g.GetNode("a")
+	a.Data = []string{"%"}
+	g.AddEdge("broadcaster-a", "broadcaster", "a", []string{})
+	g.AddEdge("a-inv", "a", "inv", []string{"low"})
+	return g
+}
+
+func TestToDOT(t *testing.T) {
+	g := exampleExportGraph()
+
+	expected := `digraph {
+  "broadcaster" [label="broadcaster"];
+  "a" [label="a\n%"];
+  "inv" [label="inv", style=filled, fillcolor="#ffcc66"];
+  "broadcaster" -> "a";
+  "a" -> "inv" [label="low", color="#ff6600", penwidth=2];
+}
+`
+
+	result := g.ToDOT(ExportOptions{
+		HighlightNodes: map[string]bool{"inv": true},
+		HighlightEdges: map[[2]string]bool{{"a", "inv"}: true},
+	})
+
+	if result != expected {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestToMermaid(t *testing.T) {
+	g := exampleExportGraph()
+	g.AddEdge("inv-a", "inv", "a", []string{})
+
+	expected := `flowchart LR
+  n0["broadcaster"]
+  n1["a<br/>%"]
+  n2["inv"]
+  n0 --- n1
+  n1 ---|"low"| n2
+  classDef highlight fill:#ffcc66
+  class n0 highlight
+  linkStyle 1 stroke:#ff6600,stroke-width:2px
+`
+
+	result := g.ToMermaid(ExportOptions{
+		Undirected:     true,
+		HighlightNodes: map[string]bool{"broadcaster": true},
+		HighlightEdges: map[[2]string]bool{{"inv", "a"}: true},
+	})
+
+	if result != expected {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestExportUnknownFormat(t *testing.T) {
+	g := exampleExportGraph()
+
+	_, err := g.Export("png", ExportOptions{})
+
+	if err == nil {
+		t.Errorf("Expected an error for an unknown format")
+	}
+}
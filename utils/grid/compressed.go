@@ -0,0 +1,136 @@
+package grid
+
+import (
+	"slices"
+	"sort"
+)
+
+// One axis of a compressed grid - cell i covers the real values [Breakpoints[i], Breakpoints[i+1])
+type CompressedAxis struct {
+	Breakpoints []int
+}
+
+// An axis with a breakpoint at each value (sorted and de-duplicated)
+func NewCompressedAxis(values []int) CompressedAxis {
+	breakpoints := slices.Clone(values)
+	slices.Sort(breakpoints)
+	return CompressedAxis{Breakpoints: slices.Compact(breakpoints)}
+}
+
+// Number of cells along the axis
+func (a CompressedAxis) Len() int {
+	return max(0, len(a.Breakpoints)-1)
+}
+
+// The cell containing a real value, or -1 if it's outside the axis
+func (a CompressedAxis) Index(value int) int {
+	i := sort.SearchInts(a.Breakpoints, value+1) - 1
+	if i < 0 || i >= a.Len() {
+		return -1
+	}
+	return i
+}
+
+// The cells overlapping the real values [from, to], clamped to the axis
+// Returns false if none of them are on the axis
+func (a CompressedAxis) Span(from int, to int) (int, int, bool) {
+	if a.Len() == 0 || to < a.Breakpoints[0] || from >= a.Breakpoints[a.Len()] {
+		return 0, 0, false
+	}
+	first, last := a.Index(from), a.Index(to)
+	if first == -1 {
+		first = 0
+	}
+	if last == -1 {
+		last = a.Len() - 1
+	}
+	return first, last, true
+}
+
+// The first real value in a cell
+func (a CompressedAxis) Value(index int) int {
+	return a.Breakpoints[index]
+}
+
+// Number of real values in a cell
+func (a CompressedAxis) Size(index int) int {
+	return a.Breakpoints[index+1] - a.Breakpoints[index]
+}
+
+// A grid over a huge coordinate space, where each cell stands for a rectangle of real points
+// Add breakpoints at v and v+1 to give a real value v a cell of its own
+type CompressedGrid struct {
+	X     CompressedAxis
+	Y     CompressedAxis
+	Cells NumberGrid
+}
+
+func NewCompressedGrid(xs []int, ys []int, fill int) CompressedGrid {
+	x := NewCompressedAxis(xs)
+	y := NewCompressedAxis(ys)
+	return CompressedGrid{X: x, Y: y, Cells: InitialiseNumberGrid(x.Len(), y.Len(), fill)}
+}
+
+// The cell containing a real point (-1 for an axis it's outside of)
+func (c CompressedGrid) ToCompressed(p Point) Point {
+	return Point{X: c.X.Index(p.X), Y: c.Y.Index(p.Y)}
+}
+
+// The top left real point of a cell
+func (c CompressedGrid) ToReal(p Point) Point {
+	return Point{X: c.X.Value(p.X), Y: c.Y.Value(p.Y)}
+}
+
+// Number of real points in a cell
+func (c CompressedGrid) CellArea(p Point) int {
+	return c.X.Size(p.X) * c.Y.Size(p.Y)
+}
+
+func (c CompressedGrid) GetPoint(p Point) int {
+	return c.Cells.GetPoint(p)
+}
+
+func (c CompressedGrid) SetPoint(p Point, value int) {
+	if c.Cells.IsPointInGrid(p) {
+		c.Cells[p.Y][p.X] = value
+	}
+}
+
+// Set every cell overlapping the real rectangle between two corners (inclusive)
+// Any part of the rectangle off the grid is ignored
+func (c CompressedGrid) FillRect(a Point, b Point, value int) {
+	fromX, toX, okX := c.X.Span(min(a.X, b.X), max(a.X, b.X))
+	fromY, toY, okY := c.Y.Span(min(a.Y, b.Y), max(a.Y, b.Y))
+	if !okX || !okY {
+		// The rectangle is entirely outside the grid
+		return
+	}
+	for y := fromY; y <= toY; y++ {
+		for x := fromX; x <= toX; x++ {
+			c.SetPoint(Point{X: x, Y: y}, value)
+		}
+	}
+}
+
+// Set the connected cells (starting from a compressed point) that match the predicate
+func (c CompressedGrid) FloodFill(start Point, value int, predicate func(int) bool) {
+	points := FloodFill(c.Cells, start, FourConnected, func(_ Point, n int) bool {
+		return predicate(n)
+	})
+	for _, p := range points {
+		c.SetPoint(p, value)
+	}
+}
+
+// Number of real points in cells with the given value
+func (c CompressedGrid) Area(value int) int {
+	area := 0
+	for y, line := range c.Cells {
+		for x, n := range line {
+			if n == value {
+				area += c.CellArea(Point{X: x, Y: y})
+			}
+		}
+	}
+	return area
+}
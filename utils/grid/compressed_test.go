@@ -0,0 +1,100 @@
+package grid
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestCompressedAxis(t *testing.T) {
+	axis := NewCompressedAxis([]int{10, 0, 1_000_000, 10, 11})
+
+	if axis.Len() != 3 {
+		t.Errorf("Expected %v, got %v", 3, axis.Len())
+	}
+
+	tests := map[int]int{-1: -1, 0: 0, 9: 0, 10: 1, 11: 2, 999_999: 2, 1_000_000: -1}
+	for value, expected := range tests {
+		if axis.Index(value) != expected {
+			t.Errorf("Expected %v for %v, got %v", expected, value, axis.Index(value))
+		}
+	}
+
+	if axis.Value(2) != 11 || axis.Size(2) != 999_989 {
+		t.Errorf("Unexpected cell %v %v", axis.Value(2), axis.Size(2))
+	}
+}
+
+func TestCompressedGrid(t *testing.T) {
+	// 2023 day 18 part 2 - the real instructions are hidden in the colour codes
+	deltas := []Point{{X: 1}, {Y: 1}, {X: -1}, {Y: -1}}
+
+	corners := []Point{{X: 0, Y: 0}}
+	for _, line := range strings.Split(readExample("../../2023/day18/input/example.txt"), "\n") {
+		hex := strings.Trim(strings.Fields(line)[2], "(#)")
+		steps, _ := strconv.ParseInt(hex[:5], 16, 64)
+		direction, _ := strconv.Atoi(hex[5:])
+
+		last, delta := corners[len(corners)-1], deltas[direction]
+		corners = append(corners, Point{X: last.X + delta.X*int(steps), Y: last.Y + delta.Y*int(steps)})
+	}
+
+	// Give each corner its own row and column, with a border around the outside
+	xs, ys := []int{}, []int{}
+	for _, p := range corners {
+		xs = append(xs, p.X-1, p.X, p.X+1, p.X+2)
+		ys = append(ys, p.Y-1, p.Y, p.Y+1, p.Y+2)
+	}
+
+	const (
+		empty = iota
+		trench
+		outside
+	)
+
+	lagoon := NewCompressedGrid(xs, ys, empty)
+	for i := 1; i < len(corners); i++ {
+		lagoon.FillRect(corners[i-1], corners[i], trench)
+	}
+
+	lagoon.FloodFill(Point{X: 0, Y: 0}, outside, func(n int) bool {
+		return n == empty
+	})
+
+	expected := 952408144115
+	result := lagoon.Area(trench) + lagoon.Area(empty)
+
+	if result != expected {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+
+	if lagoon.ToCompressed(lagoon.ToReal(Point{X: 3, Y: 4})) != (Point{X: 3, Y: 4}) {
+		t.Errorf("Expected a point to round trip")
+	}
+}
+
+func TestCompressedFillRectOffGrid(t *testing.T) {
+	// Cells cover x 0-9 and 10-19, y 0-9 and 10-19
+	g := NewCompressedGrid([]int{0, 10, 20}, []int{0, 10, 20}, 0)
+
+	// The far corner is past the last breakpoint, so only the cells on the grid are filled
+	g.FillRect(Point{X: 15, Y: 5}, Point{X: 100, Y: 100}, 1)
+
+	if g.Area(1) != 200 {
+		t.Errorf("Expected %v, got %v", 200, g.Area(1))
+	}
+
+	// Both corners are off the grid, either side of it
+	g.FillRect(Point{X: -5, Y: -5}, Point{X: 50, Y: 50}, 2)
+
+	if g.Area(2) != 400 {
+		t.Errorf("Expected %v, got %v", 400, g.Area(2))
+	}
+
+	// Entirely outside the grid
+	g.FillRect(Point{X: 20, Y: 0}, Point{X: 30, Y: 5}, 3)
+
+	if g.Area(3) != 0 {
+		t.Errorf("Expected %v, got %v", 0, g.Area(3))
+	}
+}
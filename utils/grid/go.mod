@@ -1,3 +1,7 @@
 module github.com/jmugliston/aoc/grid
 
-go 1.22.2
+replace github.com/jmugliston/aoc/utils => ../general
+
+go 1.23
+
+require github.com/jmugliston/aoc/utils v0.0.0-00010101000000-000000000000
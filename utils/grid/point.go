@@ -0,0 +1,98 @@
+package grid
+
+import "github.com/jmugliston/aoc/utils"
+
+func (p Point) Add(q Point) Point {
+	return Point{X: p.X + q.X, Y: p.Y + q.Y}
+}
+
+func (p Point) Sub(q Point) Point {
+	return Point{X: p.X - q.X, Y: p.Y - q.Y}
+}
+
+func (p Point) Scale(n int) Point {
+	return Point{X: p.X * n, Y: p.Y * n}
+}
+
+// Wrap each coordinate into the range [0, size), e.g. for positions on a toroidal map
+func (p Point) Mod(size Point) Point {
+	return Point{X: wrap(p.X, size.X), Y: wrap(p.Y, size.Y)}
+}
+
+func (p Point) Negate() Point {
+	return Point{X: -p.X, Y: -p.Y}
+}
+
+// Rotate 90 degrees clockwise around the origin (as drawn, with y pointing down)
+func (p Point) Rotate90() Point {
+	return Point{X: -p.Y, Y: p.X}
+}
+
+func (p Point) Rotate180() Point {
+	return Point{X: -p.X, Y: -p.Y}
+}
+
+// Rotate 270 degrees clockwise (90 degrees counter-clockwise) around the origin
+func (p Point) Rotate270() Point {
+	return Point{X: p.Y, Y: -p.X}
+}
+
+func sign(n int) int {
+	switch {
+	case n > 0:
+		return 1
+	case n < 0:
+		return -1
+	}
+	return 0
+}
+
+// The sign of each coordinate - a unit step in the direction of p (including diagonals)
+func (p Point) Sign() Point {
+	return Point{X: sign(p.X), Y: sign(p.Y)}
+}
+
+// The smallest whole step in the same direction as p (p divided by the GCD of its coordinates)
+func (p Point) Reduce() Point {
+	divisor := utils.Abs(utils.GCD(p.X, p.Y))
+	if divisor == 0 {
+		return p
+	}
+	return Point{X: p.X / divisor, Y: p.Y / divisor}
+}
+
+// Every grid point on the straight line from p to q (inclusive), stepping by the reduced vector
+func (p Point) LineTo(q Point) []Point {
+	step := q.Sub(p).Reduce()
+	points := []Point{p}
+	for current := p; current != q; {
+		current = current.Add(step)
+		points = append(points, current)
+	}
+	return points
+}
+
+// Distance when moving diagonally costs the same as moving straight
+func ChebyshevDistance(p1 Point, p2 Point) int {
+	d := p1.Sub(p2)
+	return max(d.X, -d.X, d.Y, -d.Y)
+}
+
+// The step taken by moving one point in a direction
+func (d Direction) Vector() Point {
+	return Point{}.NextPoint(d)
+}
+
+// The direction of a vector, if it's a (non-zero) multiple of one of the eight directions
+func DirectionOf(v Point) (Direction, bool) {
+	if v == (Point{}) || (v.X != 0 && v.Y != 0 && v.X != v.Y && v.X != -v.Y) {
+		return 0, false
+	}
+	unit := v.Sign()
+	for _, d := range Directions {
+		if d.Vector() == unit {
+			return d, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,88 @@
+package grid
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestPointArithmetic(t *testing.T) {
+	p := Point{X: 3, Y: -4}
+	q := Point{X: -1, Y: 2}
+
+	tests := []struct {
+		name     string
+		result   Point
+		expected Point
+	}{
+		{"Add", p.Add(q), Point{X: 2, Y: -2}},
+		{"Sub", p.Sub(q), Point{X: 4, Y: -6}},
+		{"Scale", p.Scale(-2), Point{X: -6, Y: 8}},
+		{"Mod", p.Mod(Point{X: 2, Y: 3}), Point{X: 1, Y: 2}},
+		{"Rotate90", p.Rotate90(), Point{X: 4, Y: 3}},
+		{"Rotate180", p.Rotate180(), Point{X: -3, Y: 4}},
+		{"Rotate270", p.Rotate270(), Point{X: -4, Y: -3}},
+		{"Sign", p.Sign(), Point{X: 1, Y: -1}},
+		{"Reduce", Point{X: 6, Y: -9}.Reduce(), Point{X: 2, Y: -3}},
+		{"Reduce zero", Point{}.Reduce(), Point{}},
+	}
+
+	for _, test := range tests {
+		if test.result != test.expected {
+			t.Errorf("%s: Expected %v, got %v", test.name, test.expected, test.result)
+		}
+	}
+
+	if ChebyshevDistance(p, q) != 6 {
+		t.Errorf("Expected %v, got %v", 6, ChebyshevDistance(p, q))
+	}
+}
+
+func TestRobotWrapping(t *testing.T) {
+	// 2024 day 14 - the robot at p=2,4 v=2,-3 on an 11x7 map
+	position := Point{X: 2, Y: 4}
+	velocity := Point{X: 2, Y: -3}
+	size := Point{X: 11, Y: 7}
+
+	for i := 0; i < 5; i++ {
+		position = position.Add(velocity).Mod(size)
+	}
+
+	expected := Point{X: 1, Y: 3}
+
+	if position != expected {
+		t.Errorf("Expected %v, got %v", expected, position)
+	}
+
+	// Jumping straight there gives the same answer
+	if (Point{X: 2, Y: 4}).Add(velocity.Scale(5)).Mod(size) != expected {
+		t.Errorf("Expected %v", expected)
+	}
+}
+
+func TestDirectionVectors(t *testing.T) {
+	for _, d := range Directions {
+		if d.Vector().Rotate90() != d.TurnRight90().Vector() {
+			t.Errorf("Expected rotating %v to match turning right", d)
+		}
+		if d.Vector().Negate() != d.Opposite().Vector() {
+			t.Errorf("Expected negating %v to match the opposite direction", d)
+		}
+		if direction, ok := DirectionOf(d.Vector().Scale(3)); !ok || direction != d {
+			t.Errorf("Expected %v, got %v", d, direction)
+		}
+	}
+
+	if _, ok := DirectionOf(Point{X: 1, Y: 2}); ok {
+		t.Errorf("Expected no direction for a knight's move")
+	}
+}
+
+func TestLineTo(t *testing.T) {
+	// 2024 day 8 - antennas at 4,3 and 8,5 line up with any point on the reduced step
+	expected := []Point{{X: 4, Y: 3}, {X: 6, Y: 4}, {X: 8, Y: 5}}
+	result := Point{X: 4, Y: 3}.LineTo(Point{X: 8, Y: 5})
+
+	if !slices.Equal(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
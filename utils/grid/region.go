@@ -0,0 +1,181 @@
+package grid
+
+import (
+	"slices"
+
+	"github.com/jmugliston/aoc/utils"
+)
+
+type Connectivity int
+
+const (
+	// North, East, South and West
+	FourConnected Connectivity = 4
+	// Including the diagonals
+	EightConnected Connectivity = 8
+)
+
+func (c Connectivity) directions() []Direction {
+	if c == EightConnected {
+		return Directions[:]
+	}
+	return []Direction{North, East, South, West}
+}
+
+// A set of connected points
+type Region struct {
+	ID     int
+	Points []Point
+	set    map[Point]bool
+}
+
+// A region from a set of connected points (e.g. from FloodFill)
+func NewRegion(id int, points []Point) Region {
+	set := make(map[Point]bool, len(points))
+	for _, p := range points {
+		set[p] = true
+	}
+	return Region{ID: id, Points: points, set: set}
+}
+
+func (r Region) Contains(p Point) bool {
+	return r.set[p]
+}
+
+func (r Region) Area() int {
+	return len(r.Points)
+}
+
+// Number of cell edges between the region and anything outside it
+func (r Region) Perimeter() int {
+	perimeter := 0
+	for _, p := range r.Points {
+		for _, d := range FourConnected.directions() {
+			if !r.set[p.NextPoint(d)] {
+				perimeter++
+			}
+		}
+	}
+	return perimeter
+}
+
+// Number of straight sides around the region (including any holes)
+// A polygon has as many sides as corners, so this counts the corners of each cell
+func (r Region) Sides() int {
+	corners := 0
+	pairs := [][3]Direction{
+		{North, East, NorthEast},
+		{East, South, SouthEast},
+		{South, West, SouthWest},
+		{West, North, NorthWest},
+	}
+	for _, p := range r.Points {
+		for _, pair := range pairs {
+			a, b, diagonal := r.set[p.NextPoint(pair[0])], r.set[p.NextPoint(pair[1])], r.set[p.NextPoint(pair[2])]
+			if !a && !b {
+				// Outside corner
+				corners++
+			} else if a && b && !diagonal {
+				// Inside corner
+				corners++
+			}
+		}
+	}
+	return corners
+}
+
+// All the points reachable from start by moving between points that match the predicate
+// Returns nothing if start itself doesn't match
+func FloodFill[G Grid[N], N string | int](g G, start Point, connectivity Connectivity, predicate func(Point, N) bool) []Point {
+	inGrid := func(p Point) bool {
+		return p.Y >= 0 && p.Y < len(g) && p.X >= 0 && p.X < len(g[p.Y])
+	}
+
+	if !inGrid(start) || !predicate(start, g[start.Y][start.X]) {
+		return []Point{}
+	}
+
+	visited := map[Point]bool{start: true}
+	points := []Point{}
+	queue := []Point{start}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		points = append(points, current)
+
+		for _, d := range connectivity.directions() {
+			next := current.NextPoint(d)
+			if visited[next] || !inGrid(next) || !predicate(next, g[next.Y][next.X]) {
+				continue
+			}
+			visited[next] = true
+			queue = append(queue, next)
+		}
+	}
+
+	return points
+}
+
+// Label every cell with the connected region of equal values it belongs to
+// Returns the regions (in reading order of their first cell) and a grid of region IDs
+func Regions[G Grid[N], N string | int](g G, connectivity Connectivity) ([]Region, NumberGrid) {
+	labels := make(NumberGrid, len(g))
+	for y := range g {
+		labels[y] = make([]int, len(g[y]))
+		for x := range labels[y] {
+			labels[y][x] = -1
+		}
+	}
+
+	regions := []Region{}
+
+	for y := range g {
+		for x := range g[y] {
+			if labels[y][x] != -1 {
+				continue
+			}
+
+			value := g[y][x]
+			points := FloodFill(g, Point{X: x, Y: y}, connectivity, func(_ Point, n N) bool {
+				return n == value
+			})
+
+			id := len(regions)
+			for _, p := range points {
+				labels[p.Y][p.X] = id
+			}
+
+			slices.SortFunc(points, func(a, b Point) int {
+				if a.Y != b.Y {
+					return a.Y - b.Y
+				}
+				return a.X - b.X
+			})
+
+			regions = append(regions, NewRegion(id, points))
+		}
+	}
+
+	return regions, labels
+}
+
+// Number of grid points on the edges of a closed loop
+// The loop can be every point along the way, or just the corners
+func BoundaryPoints(loop []Point) int {
+	boundary := 0
+	previous := loop[len(loop)-1]
+	for _, p := range loop {
+		boundary += utils.Abs(utils.GCD(p.X-previous.X, p.Y-previous.Y))
+		previous = p
+	}
+	return boundary
+}
+
+// Number of grid points strictly inside a closed loop, from the shoelace area and Pick's theorem
+// https://en.wikipedia.org/wiki/Pick%27s_theorem
+// The loop can be every point along the way, or just the corners
+func InteriorPoints(loop []Point) int {
+	// A = I + B/2 - 1 (the shoelace area is rounded down when B is odd, so B/2 is too)
+	return ShoelaceFormula(loop) - BoundaryPoints(loop)/2 + 1
+}
@@ -0,0 +1,91 @@
+package grid
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestRegions(t *testing.T) {
+	// 2024 day 12 - fence prices for each garden plot region
+	garden := Parse(readExample("../../2024/day12/input/example.txt"))
+
+	regions, labels := Regions(garden, FourConnected)
+
+	if len(regions) != 11 {
+		t.Errorf("Expected %v, got %v", 11, len(regions))
+	}
+
+	if labels[9][9] != labels[4][9] {
+		t.Errorf("Expected the E cells to share a label, got %v and %v", labels[9][9], labels[4][9])
+	}
+
+	perimeterPrice, sidesPrice := 0, 0
+	for _, region := range regions {
+		perimeterPrice += region.Area() * region.Perimeter()
+		sidesPrice += region.Area() * region.Sides()
+	}
+
+	if perimeterPrice != 1930 {
+		t.Errorf("Expected %v, got %v", 1930, perimeterPrice)
+	}
+
+	if sidesPrice != 1206 {
+		t.Errorf("Expected %v, got %v", 1206, sidesPrice)
+	}
+}
+
+func TestFloodFill(t *testing.T) {
+	g := Parse("#####\n#..##\n##.##\n###.#\n#####")
+
+	open := func(_ Point, value string) bool {
+		return value == "."
+	}
+
+	if len(FloodFill(g, Point{X: 1, Y: 1}, FourConnected, open)) != 3 {
+		t.Errorf("Expected %v, got %v", 3, len(FloodFill(g, Point{X: 1, Y: 1}, FourConnected, open)))
+	}
+
+	if len(FloodFill(g, Point{X: 1, Y: 1}, EightConnected, open)) != 4 {
+		t.Errorf("Expected %v, got %v", 4, len(FloodFill(g, Point{X: 1, Y: 1}, EightConnected, open)))
+	}
+
+	if len(FloodFill(g, Point{X: 0, Y: 0}, FourConnected, open)) != 0 {
+		t.Errorf("Expected no points when starting on a wall")
+	}
+}
+
+func TestInteriorPoints(t *testing.T) {
+	// 2023 day 18 - the lagoon holds the trench plus everything inside it
+	directions := map[string]Direction{"U": North, "R": East, "D": South, "L": West}
+
+	corners := []Point{}
+	current := Point{}
+	for _, line := range strings.Split(readExample("../../2023/day18/input/example.txt"), "\n") {
+		fields := strings.Fields(line)
+		steps, _ := strconv.Atoi(fields[1])
+		current = current.NextPoints(directions[fields[0]], steps)[steps-1]
+		corners = append(corners, current)
+	}
+
+	expected := 62
+	result := InteriorPoints(corners) + BoundaryPoints(corners)
+
+	if result != expected {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+
+	// The same loop traced point by point
+	loop := []Point{}
+	current = Point{}
+	for _, line := range strings.Split(readExample("../../2023/day18/input/example.txt"), "\n") {
+		fields := strings.Fields(line)
+		steps, _ := strconv.Atoi(fields[1])
+		loop = append(loop, current.NextPoints(directions[fields[0]], steps)...)
+		current = loop[len(loop)-1]
+	}
+
+	if InteriorPoints(loop) != 24 || BoundaryPoints(loop) != 38 {
+		t.Errorf("Expected 24 and 38, got %v and %v", InteriorPoints(loop), BoundaryPoints(loop))
+	}
+}
@@ -0,0 +1,199 @@
+package grid
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// An unbounded grid - only points that have been set are stored
+type SparseGrid[T comparable] struct {
+	Default T
+	cells   map[Point]T
+	min     Point
+	max     Point
+	// The bounding box needs recalculating after a point on its edge is removed
+	dirty bool
+}
+
+func NewSparseGrid[T comparable](defaultValue T) *SparseGrid[T] {
+	return &SparseGrid[T]{Default: defaultValue, cells: map[Point]T{}}
+}
+
+// A sparse copy of a grid, skipping any cells with the default value
+func ToSparseGrid[G ~[][]T, T comparable](g G, defaultValue T) *SparseGrid[T] {
+	sparse := NewSparseGrid(defaultValue)
+	for y, line := range g {
+		for x, value := range line {
+			sparse.SetPoint(Point{X: x, Y: y}, value)
+		}
+	}
+	return sparse
+}
+
+func (g *SparseGrid[T]) GetPoint(p Point) T {
+	if value, ok := g.cells[p]; ok {
+		return value
+	}
+	return g.Default
+}
+
+// Set a point - setting the default value removes the point
+func (g *SparseGrid[T]) SetPoint(p Point, value T) {
+	if value == g.Default {
+		g.Delete(p)
+		return
+	}
+
+	if len(g.cells) == 0 && !g.dirty {
+		g.min, g.max = p, p
+	} else if !g.dirty {
+		g.min = Point{X: min(g.min.X, p.X), Y: min(g.min.Y, p.Y)}
+		g.max = Point{X: max(g.max.X, p.X), Y: max(g.max.Y, p.Y)}
+	}
+
+	g.cells[p] = value
+}
+
+func (g *SparseGrid[T]) Delete(p Point) {
+	if _, ok := g.cells[p]; !ok {
+		return
+	}
+	delete(g.cells, p)
+	if p.X == g.min.X || p.Y == g.min.Y || p.X == g.max.X || p.Y == g.max.Y {
+		g.dirty = true
+	}
+}
+
+func (g *SparseGrid[T]) Has(p Point) bool {
+	_, ok := g.cells[p]
+	return ok
+}
+
+// Number of points that have been set
+func (g *SparseGrid[T]) Len() int {
+	return len(g.cells)
+}
+
+// Points that have been set, sorted top to bottom then left to right
+func (g *SparseGrid[T]) Points() []Point {
+	points := make([]Point, 0, len(g.cells))
+	for p := range g.cells {
+		points = append(points, p)
+	}
+	slices.SortFunc(points, func(a, b Point) int {
+		if a.Y != b.Y {
+			return a.Y - b.Y
+		}
+		return a.X - b.X
+	})
+	return points
+}
+
+func (g *SparseGrid[T]) FindAll(value T) []Point {
+	points := []Point{}
+	for _, p := range g.Points() {
+		if g.cells[p] == value {
+			points = append(points, p)
+		}
+	}
+	return points
+}
+
+// The smallest box (top left and bottom right, inclusive) containing every point that has been set
+func (g *SparseGrid[T]) Bounds() (Point, Point) {
+	if g.dirty {
+		g.dirty = false
+		first := true
+		for p := range g.cells {
+			if first {
+				g.min, g.max = p, p
+				first = false
+			}
+			g.min = Point{X: min(g.min.X, p.X), Y: min(g.min.Y, p.Y)}
+			g.max = Point{X: max(g.max.X, p.X), Y: max(g.max.Y, p.Y)}
+		}
+	}
+	if len(g.cells) == 0 {
+		return Point{}, Point{}
+	}
+	return g.min, g.max
+}
+
+// Copy the bounding box into a dense grid (the top left of the box becomes 0,0)
+func (g *SparseGrid[T]) ToGrid() [][]T {
+	topLeft, bottomRight := g.Bounds()
+	dense := make([][]T, bottomRight.Y-topLeft.Y+1)
+	for y := range dense {
+		dense[y] = make([]T, bottomRight.X-topLeft.X+1)
+		for x := range dense[y] {
+			dense[y][x] = g.GetPoint(Point{X: topLeft.X + x, Y: topLeft.Y + y})
+		}
+	}
+	return dense
+}
+
+// Render a window (top left and bottom right, inclusive) as text, one line per row
+// A nil format function uses fmt.Sprint for each cell
+func (g *SparseGrid[T]) Render(topLeft Point, bottomRight Point, format func(T) string) string {
+	return renderWindow(topLeft, bottomRight, g.GetPoint, format)
+}
+
+func (g *SparseGrid[T]) String() string {
+	topLeft, bottomRight := g.Bounds()
+	return g.Render(topLeft, bottomRight, nil)
+}
+
+func renderWindow[T any](topLeft Point, bottomRight Point, get func(Point) T, format func(T) string) string {
+	if format == nil {
+		format = func(value T) string { return fmt.Sprint(value) }
+	}
+
+	var output strings.Builder
+	for y := topLeft.Y; y <= bottomRight.Y; y++ {
+		for x := topLeft.X; x <= bottomRight.X; x++ {
+			output.WriteString(format(get(Point{X: x, Y: y})))
+		}
+		output.WriteString("\n")
+	}
+	return output.String()
+}
+
+// An infinite view of a finite grid, repeated in every direction
+// Points can be wrapped back into the original grid (for toroidal maps)
+// or mapped to the copy of the grid they fall in (for tiled maps)
+type TiledGrid[T any] struct {
+	cells  [][]T
+	Width  int
+	Height int
+}
+
+func Tiled[G ~[][]T, T any](g G) TiledGrid[T] {
+	return TiledGrid[T]{cells: g, Width: len(g[0]), Height: len(g)}
+}
+
+func wrap(value int, size int) int {
+	return ((value % size) + size) % size
+}
+
+// The point in the original grid that p corresponds to
+func (t TiledGrid[T]) Wrap(p Point) Point {
+	return Point{X: wrap(p.X, t.Width), Y: wrap(p.Y, t.Height)}
+}
+
+// Which copy of the grid p is in (the original is 0,0)
+func (t TiledGrid[T]) Tile(p Point) Point {
+	wrapped := t.Wrap(p)
+	return Point{X: (p.X - wrapped.X) / t.Width, Y: (p.Y - wrapped.Y) / t.Height}
+}
+
+func (t TiledGrid[T]) GetPoint(p Point) T {
+	wrapped := t.Wrap(p)
+	return t.cells[wrapped.Y][wrapped.X]
+}
+
+// Render a window (top left and bottom right, inclusive) as text, one line per row
+// A nil format function uses fmt.Sprint for each cell
+func (t TiledGrid[T]) Render(topLeft Point, bottomRight Point, format func(T) string) string {
+	return renderWindow(topLeft, bottomRight, t.GetPoint, format)
+}
@@ -0,0 +1,112 @@
+package grid
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func readExample(path string) string {
+	input, err := os.ReadFile(path)
+
+	if err != nil {
+		panic("Couldn't find the example file!")
+	}
+
+	return strings.TrimSpace(string(input))
+}
+
+func TestSparseGrid(t *testing.T) {
+	// 2023 day 18 - follow the dig plan, starting at 0,0
+	directions := map[string]Direction{"U": North, "R": East, "D": South, "L": West}
+
+	dig := NewSparseGrid(".")
+	current := Point{}
+	for _, line := range strings.Split(readExample("../../2023/day18/input/example.txt"), "\n") {
+		fields := strings.Fields(line)
+		steps, _ := strconv.Atoi(fields[1])
+		for _, p := range current.NextPoints(directions[fields[0]], steps) {
+			dig.SetPoint(p, "#")
+			current = p
+		}
+	}
+
+	expected := `#######
+#.....#
+###...#
+..#...#
+..#...#
+###.###
+#...#..
+##..###
+.#....#
+.######
+`
+
+	if dig.String() != expected {
+		t.Errorf("Expected %v, got %v", expected, dig.String())
+	}
+
+	if dig.Len() != 38 {
+		t.Errorf("Expected %v, got %v", 38, dig.Len())
+	}
+
+	// Removing the bottom row shrinks the bounding box
+	for x := 1; x <= 6; x++ {
+		dig.SetPoint(Point{X: x, Y: 9}, ".")
+	}
+
+	topLeft, bottomRight := dig.Bounds()
+
+	if topLeft != (Point{X: 0, Y: 0}) || bottomRight != (Point{X: 6, Y: 8}) {
+		t.Errorf("Unexpected bounds %v %v", topLeft, bottomRight)
+	}
+
+	window := dig.Render(Point{X: -1, Y: -1}, Point{X: 1, Y: 0}, nil)
+
+	if window != "...\n.##\n" {
+		t.Errorf("Expected %q, got %q", "...\n.##\n", window)
+	}
+}
+
+func TestTiledGrid(t *testing.T) {
+	// 2023 day 21 - count the garden plots reachable on an infinitely repeating map
+	garden := Parse(readExample("../../2023/day21/input/example.txt"))
+	tiled := Tiled(garden)
+
+	reachable := func(steps int) int {
+		current := map[Point]bool{garden.Find("S"): true}
+		for i := 0; i < steps; i++ {
+			next := map[Point]bool{}
+			for p := range current {
+				for _, d := range []Direction{North, East, South, West} {
+					if n := p.NextPoint(d); tiled.GetPoint(n) != "#" {
+						next[n] = true
+					}
+				}
+			}
+			current = next
+		}
+		return len(current)
+	}
+
+	for steps, expected := range map[int]int{6: 16, 10: 50, 50: 1594} {
+		result := reachable(steps)
+		if result != expected {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	}
+
+	p := Point{X: -1, Y: 23}
+
+	if tiled.Wrap(p) != (Point{X: 10, Y: 1}) || tiled.Tile(p) != (Point{X: -1, Y: 2}) {
+		t.Errorf("Unexpected wrap %v and tile %v", tiled.Wrap(p), tiled.Tile(p))
+	}
+
+	window := tiled.Render(Point{X: -2, Y: 0}, Point{X: 1, Y: 1}, nil)
+
+	if window != "....\n#...\n" {
+		t.Errorf("Unexpected window %q", window)
+	}
+}
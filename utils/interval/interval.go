@@ -0,0 +1,245 @@
+package interval
+
+import (
+	"fmt"
+	"slices"
+)
+
+// A half-open range of integers [Start, End)
+type Interval struct {
+	Start int
+	End   int
+}
+
+func New(start int, end int) Interval {
+	return Interval{Start: start, End: end}
+}
+
+// An interval including both min and max
+func Closed(min int, max int) Interval {
+	return Interval{Start: min, End: max + 1}
+}
+
+// An interval of length values starting at start
+func FromLength(start int, length int) Interval {
+	return Interval{Start: start, End: start + length}
+}
+
+func (i Interval) String() string {
+	return fmt.Sprintf("[%d,%d)", i.Start, i.End)
+}
+
+func (i Interval) Len() int {
+	return max(0, i.End-i.Start)
+}
+
+func (i Interval) IsEmpty() bool {
+	return i.End <= i.Start
+}
+
+func (i Interval) Contains(x int) bool {
+	return x >= i.Start && x < i.End
+}
+
+func (i Interval) Overlaps(o Interval) bool {
+	return !i.Intersect(o).IsEmpty()
+}
+
+func (i Interval) Intersect(o Interval) Interval {
+	return Interval{Start: max(i.Start, o.Start), End: min(i.End, o.End)}
+}
+
+func (i Interval) Shift(offset int) Interval {
+	return Interval{Start: i.Start + offset, End: i.End + offset}
+}
+
+// Split into the values below x and the values from x onwards (either may be empty)
+func (i Interval) SplitAt(x int) (Interval, Interval) {
+	x = min(max(x, i.Start), i.End)
+	return Interval{Start: i.Start, End: x}, Interval{Start: x, End: i.End}
+}
+
+// A normalised set of integers - sorted, non-overlapping and non-adjacent intervals
+type Set struct {
+	intervals []Interval
+}
+
+func NewSet(intervals ...Interval) Set {
+	return Set{intervals: normalise(intervals)}
+}
+
+func normalise(intervals []Interval) []Interval {
+	sorted := make([]Interval, 0, len(intervals))
+	for _, i := range intervals {
+		if !i.IsEmpty() {
+			sorted = append(sorted, i)
+		}
+	}
+
+	slices.SortFunc(sorted, func(a, b Interval) int {
+		return a.Start - b.Start
+	})
+
+	merged := []Interval{}
+	for _, i := range sorted {
+		last := len(merged) - 1
+		if last >= 0 && i.Start <= merged[last].End {
+			merged[last].End = max(merged[last].End, i.End)
+		} else {
+			merged = append(merged, i)
+		}
+	}
+
+	return merged
+}
+
+func (s Set) String() string {
+	return fmt.Sprint(s.intervals)
+}
+
+func (s Set) Intervals() []Interval {
+	return slices.Clone(s.intervals)
+}
+
+func (s Set) IsEmpty() bool {
+	return len(s.intervals) == 0
+}
+
+// Total number of values in the set
+func (s Set) Len() int {
+	total := 0
+	for _, i := range s.intervals {
+		total += i.Len()
+	}
+	return total
+}
+
+func (s Set) Contains(x int) bool {
+	index, found := slices.BinarySearchFunc(s.intervals, x, func(i Interval, x int) int {
+		if i.End <= x {
+			return -1
+		}
+		if i.Start > x {
+			return 1
+		}
+		return 0
+	})
+	return found && s.intervals[index].Contains(x)
+}
+
+// Smallest value in the set (panics if the set is empty)
+func (s Set) Min() int {
+	return s.intervals[0].Start
+}
+
+// Largest value in the set (panics if the set is empty)
+func (s Set) Max() int {
+	return s.intervals[len(s.intervals)-1].End - 1
+}
+
+func (s Set) Add(intervals ...Interval) Set {
+	return NewSet(append(s.Intervals(), intervals...)...)
+}
+
+func (s Set) Union(o Set) Set {
+	return s.Add(o.intervals...)
+}
+
+func (s Set) Intersect(o Set) Set {
+	result := []Interval{}
+	i, j := 0, 0
+	for i < len(s.intervals) && j < len(o.intervals) {
+		overlap := s.intervals[i].Intersect(o.intervals[j])
+		if !overlap.IsEmpty() {
+			result = append(result, overlap)
+		}
+		if s.intervals[i].End < o.intervals[j].End {
+			i++
+		} else {
+			j++
+		}
+	}
+	return Set{intervals: result}
+}
+
+// Values in s that are not in o
+func (s Set) Subtract(o Set) Set {
+	result := []Interval{}
+	j := 0
+	for _, i := range s.intervals {
+		current := i
+		// Skip intervals of o that end before this one starts
+		for j < len(o.intervals) && o.intervals[j].End <= current.Start {
+			j++
+		}
+		for k := j; k < len(o.intervals) && o.intervals[k].Start < current.End; k++ {
+			before, _ := current.SplitAt(o.intervals[k].Start)
+			if !before.IsEmpty() {
+				result = append(result, before)
+			}
+			_, current = current.SplitAt(o.intervals[k].End)
+		}
+		if !current.IsEmpty() {
+			result = append(result, current)
+		}
+	}
+	return Set{intervals: result}
+}
+
+// Split into the values below x and the values from x onwards
+func (s Set) SplitAt(x int) (Set, Set) {
+	below, above := []Interval{}, []Interval{}
+	for _, i := range s.intervals {
+		b, a := i.SplitAt(x)
+		if !b.IsEmpty() {
+			below = append(below, b)
+		}
+		if !a.IsEmpty() {
+			above = append(above, a)
+		}
+	}
+	return Set{intervals: below}, Set{intervals: above}
+}
+
+func (s Set) Shift(offset int) Set {
+	shifted := make([]Interval, len(s.intervals))
+	for i, interval := range s.intervals {
+		shifted[i] = interval.Shift(offset)
+	}
+	return Set{intervals: shifted}
+}
+
+// Moves the values in Source by Offset
+type Mapping struct {
+	Source Interval
+	Offset int
+}
+
+// Mapping in the "destination source length" style (e.g. 2023 day 5 almanac lines)
+func NewMapping(destination int, source int, length int) Mapping {
+	return Mapping{Source: FromLength(source, length), Offset: destination - source}
+}
+
+// A list of mappings applied together - the first mapping containing a value wins,
+// and values not covered by any mapping are left unchanged
+type Transform []Mapping
+
+func (t Transform) ApplyValue(x int) int {
+	for _, m := range t {
+		if m.Source.Contains(x) {
+			return x + m.Offset
+		}
+	}
+	return x
+}
+
+func (t Transform) Apply(s Set) Set {
+	result := []Interval{}
+	remaining := s
+	for _, m := range t {
+		source := NewSet(m.Source)
+		result = append(result, remaining.Intersect(source).Shift(m.Offset).intervals...)
+		remaining = remaining.Subtract(source)
+	}
+	return NewSet(append(result, remaining.intervals...)...)
+}
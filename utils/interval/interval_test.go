@@ -0,0 +1,114 @@
+package interval
+
+import (
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestSetNormalises(t *testing.T) {
+	s := NewSet(New(5, 8), New(1, 3), New(3, 4), New(10, 10), New(6, 12))
+
+	expected := []Interval{{1, 4}, {5, 12}}
+
+	if !slices.Equal(s.Intervals(), expected) {
+		t.Errorf("Expected %v, got %v", expected, s.Intervals())
+	}
+
+	if s.Len() != 10 {
+		t.Errorf("Expected %v, got %v", 10, s.Len())
+	}
+
+	if s.Contains(4) || !s.Contains(11) || s.Contains(12) {
+		t.Errorf("Unexpected membership for %v", s)
+	}
+}
+
+func TestSetOperations(t *testing.T) {
+	a := NewSet(New(0, 10), New(20, 30))
+	b := NewSet(New(5, 25), New(28, 40))
+
+	tests := []struct {
+		name     string
+		result   Set
+		expected []Interval
+	}{
+		{"union", a.Union(b), []Interval{{0, 40}}},
+		{"intersect", a.Intersect(b), []Interval{{5, 10}, {20, 25}, {28, 30}}},
+		{"subtract", a.Subtract(b), []Interval{{0, 5}, {25, 28}}},
+		{"subtract reversed", b.Subtract(a), []Interval{{10, 20}, {30, 40}}},
+	}
+
+	for _, test := range tests {
+		if !slices.Equal(test.result.Intervals(), test.expected) {
+			t.Errorf("%s: Expected %v, got %v", test.name, test.expected, test.result)
+		}
+	}
+
+	below, above := a.SplitAt(22)
+
+	if !slices.Equal(below.Intervals(), []Interval{{0, 10}, {20, 22}}) ||
+		!slices.Equal(above.Intervals(), []Interval{{22, 30}}) {
+		t.Errorf("Unexpected split %v %v", below, above)
+	}
+}
+
+func TestTransform(t *testing.T) {
+	input, err := os.ReadFile("../../2023/day05/input/example.txt")
+
+	if err != nil {
+		panic("Couldn't find the example file!")
+	}
+
+	sections := strings.Split(strings.TrimSpace(string(input)), "\n\n")
+
+	seedNumbers := []int{}
+	for _, field := range strings.Fields(strings.Split(sections[0], ":")[1]) {
+		n, _ := strconv.Atoi(field)
+		seedNumbers = append(seedNumbers, n)
+	}
+
+	transforms := []Transform{}
+	for _, section := range sections[1:] {
+		transform := Transform{}
+		for _, line := range strings.Split(section, "\n")[1:] {
+			var n [3]int
+			for i, field := range strings.Fields(line) {
+				n[i], _ = strconv.Atoi(field)
+			}
+			transform = append(transform, NewMapping(n[0], n[1], n[2]))
+		}
+		transforms = append(transforms, transform)
+	}
+
+	// Part 1 - individual seeds
+	lowest := -1
+	for _, seed := range seedNumbers {
+		for _, transform := range transforms {
+			seed = transform.ApplyValue(seed)
+		}
+		if lowest == -1 || seed < lowest {
+			lowest = seed
+		}
+	}
+
+	if lowest != 35 {
+		t.Errorf("Expected %v, got %v", 35, lowest)
+	}
+
+	// Part 2 - ranges of seeds
+	seeds := NewSet()
+	for i := 0; i < len(seedNumbers); i += 2 {
+		seeds = seeds.Add(FromLength(seedNumbers[i], seedNumbers[i+1]))
+	}
+
+	for _, transform := range transforms {
+		seeds = transform.Apply(seeds)
+	}
+
+	if seeds.Min() != 46 {
+		t.Errorf("Expected %v, got %v", 46, seeds.Min())
+	}
+}
@@ -0,0 +1,282 @@
+package linalg
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Exact linear algebra over the rationals - no float rounding errors
+
+var ErrNoSolution = errors.New("System has no solution")
+var ErrNotUnique = errors.New("System has infinitely many solutions")
+var ErrNotIntegral = errors.New("System has no integer solution")
+
+// A matrix of rationals, indexed by row then column
+type Matrix [][]*big.Rat
+
+// A rows x cols matrix of zeros
+func NewMatrix(rows int, cols int) Matrix {
+	m := make(Matrix, rows)
+	for i := range m {
+		m[i] = make([]*big.Rat, cols)
+		for j := range m[i] {
+			m[i][j] = new(big.Rat)
+		}
+	}
+	return m
+}
+
+func FromInts(values [][]int) Matrix {
+	m := make(Matrix, len(values))
+	for i, row := range values {
+		m[i] = make([]*big.Rat, len(row))
+		for j, value := range row {
+			m[i][j] = big.NewRat(int64(value), 1)
+		}
+	}
+	return m
+}
+
+func FromBigInts(values [][]*big.Int) Matrix {
+	m := make(Matrix, len(values))
+	for i, row := range values {
+		m[i] = make([]*big.Rat, len(row))
+		for j, value := range row {
+			m[i][j] = new(big.Rat).SetInt(value)
+		}
+	}
+	return m
+}
+
+// A column vector from a list of integers
+func Vector(values ...int) []*big.Rat {
+	vector := make([]*big.Rat, len(values))
+	for i, value := range values {
+		vector[i] = big.NewRat(int64(value), 1)
+	}
+	return vector
+}
+
+func (m Matrix) Rows() int {
+	return len(m)
+}
+
+func (m Matrix) Cols() int {
+	if len(m) == 0 {
+		return 0
+	}
+	return len(m[0])
+}
+
+// A deep copy, so the original is not changed by row operations
+func (m Matrix) Clone() Matrix {
+	clone := make(Matrix, len(m))
+	for i, row := range m {
+		clone[i] = make([]*big.Rat, len(row))
+		for j, value := range row {
+			clone[i][j] = new(big.Rat).Set(value)
+		}
+	}
+	return clone
+}
+
+// The matrix with the vector added as an extra column
+func (m Matrix) Augment(b []*big.Rat) Matrix {
+	augmented := m.Clone()
+	for i := range augmented {
+		augmented[i] = append(augmented[i], new(big.Rat).Set(b[i]))
+	}
+	return augmented
+}
+
+func (m Matrix) String() string {
+	rows := make([]string, len(m))
+	for i, row := range m {
+		values := make([]string, len(row))
+		for j, value := range row {
+			values[j] = value.RatString()
+		}
+		rows[i] = "[" + strings.Join(values, " ") + "]"
+	}
+	return strings.Join(rows, "\n")
+}
+
+// Gauss-Jordan elimination - https://en.wikipedia.org/wiki/Gaussian_elimination
+// Returns the reduced row echelon form and the pivot column of each non-zero row
+func reduce(m Matrix) (Matrix, []int) {
+	m = m.Clone()
+	pivots := []int{}
+
+	row := 0
+	for col := 0; col < m.Cols() && row < m.Rows(); col++ {
+		// Find a row with a non-zero value in this column
+		pivot := -1
+		for i := row; i < m.Rows(); i++ {
+			if m[i][col].Sign() != 0 {
+				pivot = i
+				break
+			}
+		}
+		if pivot == -1 {
+			continue
+		}
+
+		if pivot != row {
+			m[pivot], m[row] = m[row], m[pivot]
+		}
+
+		// Scale the pivot to 1, then clear the column in every other row
+		scale := new(big.Rat).Inv(m[row][col])
+		for j := col; j < m.Cols(); j++ {
+			m[row][j].Mul(m[row][j], scale)
+		}
+
+		for i := 0; i < m.Rows(); i++ {
+			if i == row || m[i][col].Sign() == 0 {
+				continue
+			}
+			factor := new(big.Rat).Set(m[i][col])
+			for j := col; j < m.Cols(); j++ {
+				m[i][j].Sub(m[i][j], new(big.Rat).Mul(factor, m[row][j]))
+			}
+		}
+
+		pivots = append(pivots, col)
+		row++
+	}
+
+	return m, pivots
+}
+
+// The reduced row echelon form of the matrix
+func ReducedRowEchelon(m Matrix) Matrix {
+	reduced, _ := reduce(m)
+	return reduced
+}
+
+// Number of linearly independent rows
+func Rank(m Matrix) int {
+	_, pivots := reduce(m)
+	return len(pivots)
+}
+
+func Determinant(m Matrix) (*big.Rat, error) {
+	if m.Rows() != m.Cols() {
+		return nil, fmt.Errorf("Determinant of a %dx%d matrix is undefined", m.Rows(), m.Cols())
+	}
+
+	// Eliminate without scaling rows, so the determinant is the product of the diagonal
+	m = m.Clone()
+	determinant := big.NewRat(1, 1)
+
+	for col := 0; col < m.Cols(); col++ {
+		pivot := -1
+		for i := col; i < m.Rows(); i++ {
+			if m[i][col].Sign() != 0 {
+				pivot = i
+				break
+			}
+		}
+		if pivot == -1 {
+			return new(big.Rat), nil
+		}
+		if pivot != col {
+			m[pivot], m[col] = m[col], m[pivot]
+			determinant.Neg(determinant)
+		}
+
+		determinant.Mul(determinant, m[col][col])
+
+		for i := col + 1; i < m.Rows(); i++ {
+			factor := new(big.Rat).Quo(m[i][col], m[col][col])
+			for j := col; j < m.Cols(); j++ {
+				m[i][j].Sub(m[i][j], new(big.Rat).Mul(factor, m[col][j]))
+			}
+		}
+	}
+
+	return determinant, nil
+}
+
+// The solutions of a system of linear equations
+type Solution struct {
+	// One solution, with every free variable set to 0
+	Values []*big.Rat
+	// Variables that can take any value (none if the solution is unique)
+	Free []int
+}
+
+func (s Solution) IsUnique() bool {
+	return len(s.Free) == 0
+}
+
+// Whether every value is a whole number
+func (s Solution) IsIntegral() bool {
+	for _, value := range s.Values {
+		if !value.IsInt() {
+			return false
+		}
+	}
+	return true
+}
+
+// Solve a x = b for any number of equations (rows) and unknowns (columns)
+func Solve(a Matrix, b []*big.Rat) (Solution, error) {
+	if len(b) != a.Rows() {
+		return Solution{}, fmt.Errorf("Expected %d values, got %d", a.Rows(), len(b))
+	}
+
+	reduced, pivots := reduce(a.Augment(b))
+	unknowns := a.Cols()
+
+	// A pivot in the last column means 0 = 1
+	if len(pivots) > 0 && pivots[len(pivots)-1] == unknowns {
+		return Solution{}, ErrNoSolution
+	}
+
+	solution := Solution{Values: make([]*big.Rat, unknowns)}
+	for i := range solution.Values {
+		solution.Values[i] = new(big.Rat)
+	}
+
+	isPivot := map[int]bool{}
+	for row, col := range pivots {
+		isPivot[col] = true
+		solution.Values[col].Set(reduced[row][unknowns])
+	}
+
+	for col := 0; col < unknowns; col++ {
+		if !isPivot[col] {
+			solution.Free = append(solution.Free, col)
+		}
+	}
+
+	return solution, nil
+}
+
+// Solve a x = b where the answer must be a unique set of integers
+// Returns an error if there is no solution, more than one, or it has fractions
+func SolveInts(a [][]int, b []int) ([]int, error) {
+	solution, err := Solve(FromInts(a), Vector(b...))
+	if err != nil {
+		return nil, err
+	}
+	if !solution.IsUnique() {
+		return nil, ErrNotUnique
+	}
+	if !solution.IsIntegral() {
+		return nil, ErrNotIntegral
+	}
+
+	values := make([]int, len(solution.Values))
+	for i, value := range solution.Values {
+		if !value.Num().IsInt64() {
+			return nil, fmt.Errorf("Solution %s overflows an int", value.RatString())
+		}
+		values[i] = int(value.Num().Int64())
+	}
+
+	return values, nil
+}
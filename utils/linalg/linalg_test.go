@@ -0,0 +1,154 @@
+package linalg
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"testing"
+)
+
+func readExample(path string) string {
+	input, err := os.ReadFile(path)
+
+	if err != nil {
+		panic("Couldn't find the example file!")
+	}
+
+	return strings.TrimSpace(string(input))
+}
+
+func TestSolveInts(t *testing.T) {
+	// 2024 day 13 - only some claw machines can win the prize
+	total := 0
+	for _, block := range strings.Split(readExample("../../2024/day13/input/example.txt"), "\n\n") {
+		var ax, ay, bx, by, px, py int
+		fmt.Sscanf(block, "Button A: X+%d, Y+%d\nButton B: X+%d, Y+%d\nPrize: X=%d, Y=%d", &ax, &ay, &bx, &by, &px, &py)
+
+		presses, err := SolveInts([][]int{{ax, bx}, {ay, by}}, []int{px, py})
+
+		if errors.Is(err, ErrNotIntegral) {
+			continue
+		}
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		total += presses[0]*3 + presses[1]
+	}
+
+	if total != 480 {
+		t.Errorf("Expected %v, got %v", 480, total)
+	}
+}
+
+func TestSolveHailstones(t *testing.T) {
+	// 2023 day 24 - the rock (P, V) hits every hailstone (p, v), so (P - p) x (V - v) = 0
+	// Subtracting the equation for one hailstone from another cancels the P x V term
+	type hailstone struct{ p, v [3]int }
+
+	hailstones := []hailstone{}
+	for _, line := range strings.Split(readExample("../../2023/day24/input/example.txt"), "\n") {
+		var h hailstone
+		fmt.Sscanf(strings.ReplaceAll(line, " ", ""), "%d,%d,%d@%d,%d,%d", &h.p[0], &h.p[1], &h.p[2], &h.v[0], &h.v[1], &h.v[2])
+		hailstones = append(hailstones, h)
+	}
+
+	cross := func(a, b [3]int) [3]int {
+		return [3]int{a[1]*b[2] - a[2]*b[1], a[2]*b[0] - a[0]*b[2], a[0]*b[1] - a[1]*b[0]}
+	}
+
+	a := [][]int{}
+	b := []int{}
+	first := hailstones[0]
+	for _, other := range []hailstone{hailstones[1], hailstones[3]} {
+		d, e := [3]int{}, [3]int{}
+		for i := range d {
+			d[i] = other.v[i] - first.v[i]
+			e[i] = other.p[i] - first.p[i]
+		}
+		a = append(a,
+			[]int{0, d[2], -d[1], 0, -e[2], e[1]},
+			[]int{-d[2], 0, d[0], e[2], 0, -e[0]},
+			[]int{d[1], -d[0], 0, -e[1], e[0], 0},
+		)
+		c1, c0 := cross(other.p, other.v), cross(first.p, first.v)
+		for i := range c1 {
+			b = append(b, c1[i]-c0[i])
+		}
+	}
+
+	rock, err := SolveInts(a, b)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := 47
+	result := rock[0] + rock[1] + rock[2]
+
+	if result != expected {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestSolve(t *testing.T) {
+	// x + y = 2 and 2x + 2y = 4 leaves y free
+	solution, err := Solve(FromInts([][]int{{1, 1}, {2, 2}}), Vector(2, 4))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if solution.IsUnique() || len(solution.Free) != 1 || solution.Free[0] != 1 {
+		t.Errorf("Expected y to be free, got %v", solution.Free)
+	}
+
+	_, err = Solve(FromInts([][]int{{1, 1}, {2, 2}}), Vector(2, 5))
+
+	if !errors.Is(err, ErrNoSolution) {
+		t.Errorf("Expected %v, got %v", ErrNoSolution, err)
+	}
+
+	// 2x + 4y = 3 and x - y = 0
+	solution, _ = Solve(FromInts([][]int{{2, 4}, {1, -1}}), Vector(3, 0))
+
+	if solution.IsIntegral() || solution.Values[0].Cmp(big.NewRat(1, 2)) != 0 {
+		t.Errorf("Expected %v, got %v", "1/2", solution.Values[0].RatString())
+	}
+}
+
+func TestDeterminantAndRank(t *testing.T) {
+	m := FromInts([][]int{{0, 2, 1}, {3, -1, 2}, {1, 1, 1}})
+
+	determinant, err := Determinant(m)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if determinant.Cmp(big.NewRat(2, 1)) != 0 {
+		t.Errorf("Expected %v, got %v", 2, determinant.RatString())
+	}
+
+	if Rank(m) != 3 {
+		t.Errorf("Expected %v, got %v", 3, Rank(m))
+	}
+
+	if Rank(FromInts([][]int{{1, 2, 3}, {2, 4, 6}})) != 1 {
+		t.Errorf("Expected %v, got %v", 1, Rank(FromInts([][]int{{1, 2, 3}, {2, 4, 6}})))
+	}
+
+	if _, err := Determinant(FromInts([][]int{{1, 2}})); err == nil {
+		t.Errorf("Expected an error for a non-square matrix")
+	}
+
+	expected := "[1 0 1]\n[0 1 1/2]\n[0 0 0]"
+	result := ReducedRowEchelon(FromInts([][]int{{2, 0, 2}, {0, 2, 1}, {2, 2, 3}})).String()
+
+	if result != expected {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
@@ -0,0 +1,3 @@
+module github.com/jmugliston/aoc/memo
+
+go 1.22.2
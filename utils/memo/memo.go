@@ -0,0 +1,154 @@
+package memo
+
+import (
+	"container/list"
+	"sync"
+)
+
+type Stats struct {
+	Hits   int
+	Misses int
+	Size   int
+}
+
+type config struct {
+	limit      int
+	concurrent bool
+}
+
+type Option func(*config)
+
+// Keep at most n results, evicting the least recently used
+func WithLimit(n int) Option {
+	return func(c *config) {
+		c.limit = n
+	}
+}
+
+// Guard the cache with a mutex so it can be shared between goroutines
+func Concurrent() Option {
+	return func(c *config) {
+		c.concurrent = true
+	}
+}
+
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// A cache of results for a function, keyed by its argument
+type Cache[K comparable, V any] struct {
+	fn      func(self func(K) V, key K) V
+	limit   int
+	mutex   *sync.Mutex
+	entries map[K]*list.Element
+	order   *list.List
+	hits    int
+	misses  int
+}
+
+// Memoize a function - a limit of 0 means the cache is unbounded
+func New[K comparable, V any](fn func(K) V, options ...Option) *Cache[K, V] {
+	return NewRecursive(func(_ func(K) V, key K) V {
+		return fn(key)
+	}, options...)
+}
+
+// Memoize a self-recursive function - recursive calls should go through self so they are cached too
+//
+//	fib := memo.NewRecursive(func(self func(int) int, n int) int {
+//		if n < 2 {
+//			return n
+//		}
+//		return self(n-1) + self(n-2)
+//	})
+func NewRecursive[K comparable, V any](fn func(self func(K) V, key K) V, options ...Option) *Cache[K, V] {
+	c := config{}
+	for _, option := range options {
+		option(&c)
+	}
+
+	cache := &Cache[K, V]{
+		fn:      fn,
+		limit:   c.limit,
+		entries: map[K]*list.Element{},
+		order:   list.New(),
+	}
+
+	if c.concurrent {
+		cache.mutex = &sync.Mutex{}
+	}
+
+	return cache
+}
+
+func (c *Cache[K, V]) lock() {
+	if c.mutex != nil {
+		c.mutex.Lock()
+	}
+}
+
+func (c *Cache[K, V]) unlock() {
+	if c.mutex != nil {
+		c.mutex.Unlock()
+	}
+}
+
+// The (cached) result of the function for key
+func (c *Cache[K, V]) Get(key K) V {
+	c.lock()
+	if element, ok := c.entries[key]; ok {
+		c.hits++
+		c.order.MoveToFront(element)
+		value := element.Value.(*entry[K, V]).value
+		c.unlock()
+		return value
+	}
+	c.misses++
+	c.unlock()
+
+	// Compute without holding the lock, so recursive calls (and other goroutines) can use the cache
+	value := c.fn(c.Get, key)
+
+	c.lock()
+	defer c.unlock()
+
+	if element, ok := c.entries[key]; ok {
+		// Another goroutine got there first
+		element.Value.(*entry[K, V]).value = value
+		c.order.MoveToFront(element)
+		return value
+	}
+
+	c.entries[key] = c.order.PushFront(&entry[K, V]{key: key, value: value})
+
+	if c.limit > 0 && c.order.Len() > c.limit {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*entry[K, V]).key)
+	}
+
+	return value
+}
+
+// The memoized function, for passing around in place of the original
+func (c *Cache[K, V]) Func() func(K) V {
+	return c.Get
+}
+
+func (c *Cache[K, V]) Stats() Stats {
+	c.lock()
+	defer c.unlock()
+	return Stats{Hits: c.hits, Misses: c.misses, Size: c.order.Len()}
+}
+
+// Empty the cache and reset the stats
+func (c *Cache[K, V]) Reset() {
+	c.lock()
+	defer c.unlock()
+	c.entries = map[K]*list.Element{}
+	c.order.Init()
+	c.hits = 0
+	c.misses = 0
+}
@@ -0,0 +1,124 @@
+package memo
+
+import (
+	"os"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestRecursive(t *testing.T) {
+	fib := NewRecursive(func(self func(int) int, n int) int {
+		if n < 2 {
+			return n
+		}
+		return self(n-1) + self(n-2)
+	})
+
+	expected := 12586269025
+	result := fib.Get(50)
+
+	if result != expected {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+
+	stats := fib.Stats()
+
+	if stats.Misses != 51 || stats.Hits != 48 || stats.Size != 51 {
+		t.Errorf("Unexpected stats %+v", stats)
+	}
+
+	fib.Reset()
+
+	if fib.Stats() != (Stats{}) {
+		t.Errorf("Expected empty stats, got %+v", fib.Stats())
+	}
+}
+
+func TestLimit(t *testing.T) {
+	calls := 0
+	square := New(func(n int) int {
+		calls++
+		return n * n
+	}, WithLimit(2))
+
+	square.Get(1)
+	square.Get(2)
+	square.Get(1)
+	square.Get(3) // Evicts 2, the least recently used
+	square.Get(1)
+	square.Get(2)
+
+	if calls != 4 {
+		t.Errorf("Expected %v, got %v", 4, calls)
+	}
+
+	if square.Stats().Size != 2 {
+		t.Errorf("Expected %v, got %v", 2, square.Stats().Size)
+	}
+}
+
+func TestConcurrent(t *testing.T) {
+	type key struct {
+		a int
+		b int
+	}
+
+	ways := NewRecursive(func(self func(key) int, k key) int {
+		if k.a == 0 || k.b == 0 {
+			return 1
+		}
+		return self(key{k.a - 1, k.b}) + self(key{k.a, k.b - 1})
+	}, Concurrent())
+
+	var wg sync.WaitGroup
+	results := make([]int, 8)
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = ways.Get(key{16, 16})
+		}()
+	}
+	wg.Wait()
+
+	for _, result := range results {
+		if result != 601080390 {
+			t.Errorf("Expected %v, got %v", 601080390, result)
+		}
+	}
+}
+
+func TestTowels(t *testing.T) {
+	// 2024 day 19 - count the ways each design can be made from the towel patterns
+	input, err := os.ReadFile("../../2024/day19/input/example.txt")
+
+	if err != nil {
+		panic("Couldn't find the example file!")
+	}
+
+	split := strings.Split(strings.TrimSpace(string(input)), "\n\n")
+	patterns := strings.Split(split[0], ", ")
+
+	matchCount := NewRecursive(func(self func(string) int, design string) int {
+		if len(design) == 0 {
+			return 1
+		}
+		count := 0
+		for _, pattern := range patterns {
+			if strings.HasPrefix(design, pattern) {
+				count += self(design[len(pattern):])
+			}
+		}
+		return count
+	})
+
+	total := 0
+	for _, design := range strings.Split(split[1], "\n") {
+		total += matchCount.Get(design)
+	}
+
+	if total != 16 {
+		t.Errorf("Expected %v, got %v", 16, total)
+	}
+}
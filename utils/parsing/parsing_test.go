@@ -0,0 +1,101 @@
+package parsing
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParseNumbers(t *testing.T) {
+	result, err := ParseNumbers(" 3  -4 5 ")
+
+	if err != nil || !slices.Equal(result, []int{3, -4, 5}) {
+		t.Errorf("Expected %v, got %v (%v)", []int{3, -4, 5}, result, err)
+	}
+
+	_, err = ParseNumbers("3 four 5")
+
+	if err == nil {
+		t.Errorf("Expected an error for a word")
+	}
+
+	_, err = ParseLinesOfNumbers("1 2\n3 x")
+
+	if err == nil || err.Error()[:6] != "Line 2" {
+		t.Errorf("Expected an error on line 2, got %v", err)
+	}
+
+	_, err = ParseDigits("12a4")
+
+	if err == nil {
+		t.Errorf("Expected an error for a letter")
+	}
+}
+
+func TestMust(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected a panic")
+		}
+	}()
+
+	MustReadNumbers("1 2 three")
+}
+
+func TestInts(t *testing.T) {
+	tests := map[string][]int{
+		"p=0,4 v=3,-3":                     {0, 4, 3, -3},
+		"Button A: X+94, Y+34":             {94, 34},
+		"19, 13, 30 @ -2,  1, -2":          {19, 13, 30, -2, 1, -2},
+		"Game 12: 3 blue, 4 red; 1 red":    {12, 3, 4, 1},
+		"no numbers":                       nil,
+		"Register A: 729\nRegister B: 0\n": {729, 0},
+	}
+
+	for input, expected := range tests {
+		result := MustParseInts(input)
+		if !slices.Equal(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	}
+}
+
+func TestScan(t *testing.T) {
+	var px, py, vx, vy int
+	err := Scan("p=0,4 v=3,-3", "p=%d,%d v=%d,%d", &px, &py, &vx, &vy)
+
+	if err != nil || px != 0 || py != 4 || vx != 3 || vy != -3 {
+		t.Errorf("Unexpected result %v %v %v %v (%v)", px, py, vx, vy, err)
+	}
+
+	var source, target string
+	var operator rune
+	MustScan("%ab -> cd", "%c%s -> %s", &operator, &source, &target)
+
+	if operator != '%' || source != "ab" || target != "cd" {
+		t.Errorf("Unexpected result %c %v %v", operator, source, target)
+	}
+
+	var percent int
+	MustScan("50% done", "%d%% done", &percent)
+
+	if percent != 50 {
+		t.Errorf("Expected %v, got %v", 50, percent)
+	}
+
+	if Scan("p=0,4", "p=%d,%d v=%d,%d", &px, &py, &vx, &vy) == nil {
+		t.Errorf("Expected an error for a line that doesn't match")
+	}
+
+	if Scan("p=0,4", "p=%d,%d", &px) == nil {
+		t.Errorf("Expected an error for missing args")
+	}
+}
+
+func TestBlocks(t *testing.T) {
+	result := Blocks("\na\nb\n\nc\n \nd\r\n\r\ne\n")
+	expected := []string{"a\nb", "c", "d", "e"}
+
+	if !slices.Equal(result, expected) {
+		t.Errorf("Expected %q, got %q", expected, result)
+	}
+}
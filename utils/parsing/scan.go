@@ -0,0 +1,116 @@
+package parsing
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+var scanPatterns sync.Map
+
+// Build (and cache) the regex for a Scan format
+func scanPattern(format string) (*regexp.Regexp, error) {
+	if pattern, ok := scanPatterns.Load(format); ok {
+		return pattern.(*regexp.Regexp), nil
+	}
+
+	var pattern strings.Builder
+	pattern.WriteString("^")
+
+	literal := strings.Builder{}
+	flush := func() {
+		pattern.WriteString(regexp.QuoteMeta(literal.String()))
+		literal.Reset()
+	}
+
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			literal.WriteByte(format[i])
+			continue
+		}
+
+		if i+1 == len(format) {
+			return nil, fmt.Errorf("Format %q ends with %%", format)
+		}
+
+		i++
+		switch format[i] {
+		case '%':
+			literal.WriteByte('%')
+		case 'd':
+			flush()
+			pattern.WriteString(`([-+]?\d+)`)
+		case 's':
+			flush()
+			pattern.WriteString(`(.+?)`)
+		case 'c':
+			flush()
+			pattern.WriteString(`(.)`)
+		default:
+			return nil, fmt.Errorf("Unknown verb %%%c in format %q", format[i], format)
+		}
+	}
+
+	flush()
+	pattern.WriteString("$")
+
+	compiled, err := regexp.Compile(pattern.String())
+	if err != nil {
+		return nil, err
+	}
+
+	scanPatterns.Store(format, compiled)
+
+	return compiled, nil
+}
+
+// Match a whole line against a format, storing the values in args
+//
+// Supported verbs are %d (signed integer, into *int), %s (text, into *string),
+// %c (single character, into *rune or *string) and %% (a literal %)
+//
+//	var px, py, vx, vy int
+//	err := parsing.Scan("p=0,4 v=3,-3", "p=%d,%d v=%d,%d", &px, &py, &vx, &vy)
+func Scan(line string, format string, args ...any) error {
+	pattern, err := scanPattern(format)
+	if err != nil {
+		return err
+	}
+
+	if pattern.NumSubexp() != len(args) {
+		return fmt.Errorf("Format %q has %d verbs but %d args were given", format, pattern.NumSubexp(), len(args))
+	}
+
+	matches := pattern.FindStringSubmatch(line)
+	if matches == nil {
+		return fmt.Errorf("Line %q does not match format %q", line, format)
+	}
+
+	for i, arg := range args {
+		value := matches[i+1]
+		switch target := arg.(type) {
+		case *int:
+			number, err := strconv.Atoi(value)
+			if err != nil {
+				return fmt.Errorf("Invalid number %q: %w", value, err)
+			}
+			*target = number
+		case *string:
+			*target = value
+		case *rune:
+			*target = []rune(value)[0]
+		default:
+			return fmt.Errorf("Unsupported arg type %T", arg)
+		}
+	}
+
+	return nil
+}
+
+func MustScan(line string, format string, args ...any) {
+	if err := Scan(line, format, args...); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,104 @@
+package parsing
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var integerPattern = regexp.MustCompile(`[-+]?\d+`)
+
+var blankLinePattern = regexp.MustCompile(`\r?\n[ \t]*\r?\n`)
+
+// Panics if err is not nil, otherwise returns value
+//
+//	numbers := parsing.Must(parsing.ParseNumbers(line))
+func Must[T any](value T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
+// Like ReadNumbers, but returns an error for anything that isn't a number
+func ParseNumbers(input string) ([]int, error) {
+	var numbers []int
+
+	for _, field := range strings.Fields(input) {
+		number, err := strconv.Atoi(field)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid number %q: %w", field, err)
+		}
+		numbers = append(numbers, number)
+	}
+
+	return numbers, nil
+}
+
+func MustReadNumbers(input string) []int {
+	return Must(ParseNumbers(input))
+}
+
+// Like ReadDigits, but returns an error for anything that isn't a digit
+func ParseDigits(input string) ([]int, error) {
+	var digits []int
+
+	for i, char := range input {
+		if char < '0' || char > '9' {
+			return nil, fmt.Errorf("Invalid digit %q at column %d", char, i+1)
+		}
+		digits = append(digits, int(char-'0'))
+	}
+
+	return digits, nil
+}
+
+func MustReadDigits(input string) []int {
+	return Must(ParseDigits(input))
+}
+
+// Like ReadLinesOfNumbers, but returns an error (with the line number) for anything that isn't a number
+func ParseLinesOfNumbers(input string) ([][]int, error) {
+	var lines [][]int
+
+	for i, line := range ReadLines(input) {
+		numbers, err := ParseNumbers(line)
+		if err != nil {
+			return nil, fmt.Errorf("Line %d: %w", i+1, err)
+		}
+		lines = append(lines, numbers)
+	}
+
+	return lines, nil
+}
+
+func MustReadLinesOfNumbers(input string) [][]int {
+	return Must(ParseLinesOfNumbers(input))
+}
+
+// All the (optionally signed) integers in the input, ignoring any other text
+// e.g. "p=0,4 v=3,-3" -> [0 4 3 -3]
+func ParseInts(input string) ([]int, error) {
+	var numbers []int
+
+	for _, match := range integerPattern.FindAllString(input, -1) {
+		number, err := strconv.Atoi(match)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid number %q: %w", match, err)
+		}
+		numbers = append(numbers, number)
+	}
+
+	return numbers, nil
+}
+
+// Like ParseInts, but panics on an error
+func MustParseInts(input string) []int {
+	return Must(ParseInts(input))
+}
+
+// Split the input into blocks separated by blank lines
+func Blocks(input string) []string {
+	return blankLinePattern.Split(strings.TrimSpace(input), -1)
+}
@@ -0,0 +1,347 @@
+package parsing
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// An error while unmarshalling, with the (1-based) position in the input it relates to
+type UnmarshalError struct {
+	Line   int
+	Column int
+	Err    error
+}
+
+func (e *UnmarshalError) Error() string {
+	return fmt.Sprintf("line %d, column %d: %v", e.Line, e.Column, e.Err)
+}
+
+func (e *UnmarshalError) Unwrap() error {
+	return e.Err
+}
+
+// A piece of the input, and where it starts
+type chunk struct {
+	text   string
+	line   int
+	column int
+}
+
+// The chunk from offset to end (a byte offset into the text)
+func (c chunk) from(offset int) chunk {
+	before := c.text[:offset]
+	newlines := strings.Count(before, "\n")
+	if newlines == 0 {
+		return chunk{text: c.text[offset:], line: c.line, column: c.column + offset}
+	}
+	return chunk{text: c.text[offset:], line: c.line + newlines, column: offset - strings.LastIndex(before, "\n")}
+}
+
+func (c chunk) slice(start, end int) chunk {
+	sub := c.from(start)
+	sub.text = sub.text[:end-start]
+	return sub
+}
+
+func (c chunk) trim() chunk {
+	trimmed := c.from(len(c.text) - len(strings.TrimLeftFunc(c.text, unicode.IsSpace)))
+	trimmed.text = strings.TrimRightFunc(trimmed.text, unicode.IsSpace)
+	return trimmed
+}
+
+func (c chunk) split(separator *regexp.Regexp) []chunk {
+	chunks := []chunk{}
+	start := 0
+	for _, match := range separator.FindAllStringIndex(c.text, -1) {
+		chunks = append(chunks, c.slice(start, match[0]))
+		start = match[1]
+	}
+	return append(chunks, c.from(start))
+}
+
+func (c chunk) errorf(format string, args ...any) error {
+	return &UnmarshalError{Line: c.line, Column: c.column, Err: fmt.Errorf(format, args...)}
+}
+
+var newlinePattern = regexp.MustCompile(`\r?\n`)
+
+var wordPattern = regexp.MustCompile(`\S+`)
+
+// Fill v (a pointer) from the puzzle input, using struct tags to describe the format
+//
+// A struct is filled section by section - each exported field takes the next block of the
+// input, where blocks are separated by blank lines. A slice takes one element per block if
+// the input has blank lines, otherwise one per line, and a slice of numbers or strings on a
+// single line takes every number or word (or uses the separator in a `split:","` tag).
+//
+// A struct for a single line (or block) is filled in one of two ways:
+//   - From a line format in the tag of a blank field (_ struct{}) or of the field holding it.
+//     A `pattern` uses the verbs from Scan and a `regex` uses capture groups, filling the
+//     exported fields in order (or by name for named groups).
+//   - From a `find:"regex"` tag on each field, which searches for the field's text (the first
+//     capture group, or the whole match). A struct field with a `pattern` or `regex` tag of its
+//     own (and no find tag) is matched against the whole text instead.
+//
+//	var puzzle struct {
+//		Workflows []struct {
+//			_     struct{} `pattern:"%s{%s}"`
+//			Name  string
+//			Rules []string `split:","`
+//		}
+//		Parts []struct {
+//			_          struct{} `pattern:"{x=%d,m=%d,a=%d,s=%d}"`
+//			X, M, A, S int
+//		}
+//	}
+//	err := parsing.Unmarshal(input, &puzzle)
+func Unmarshal(input string, v any) error {
+	target := reflect.ValueOf(v)
+	if target.Kind() != reflect.Pointer || target.IsNil() {
+		return errors.New("Unmarshal requires a non-nil pointer")
+	}
+
+	c := chunk{text: input, line: 1, column: 1}.trim()
+
+	if target.Elem().Kind() == reflect.Struct && lineFormat(target.Elem().Type(), "") == nil {
+		return decodeSections(c, target.Elem())
+	}
+
+	return decodeValue(c, target.Elem(), "")
+}
+
+func exportedFields(t reflect.Type) []reflect.StructField {
+	fields := []reflect.StructField{}
+	for _, field := range reflect.VisibleFields(t) {
+		if field.IsExported() && !field.Anonymous {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+func hasFormat(tag reflect.StructTag) bool {
+	_, pattern := tag.Lookup("pattern")
+	_, regex := tag.Lookup("regex")
+	return pattern || regex
+}
+
+// The line format for a struct, from the tag of the field holding it or its own blank field
+func lineFormat(t reflect.Type, tag reflect.StructTag) *reflect.StructTag {
+	if hasFormat(tag) {
+		return &tag
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+	for i := 0; i < t.NumField(); i++ {
+		if field := t.Field(i); field.Name == "_" {
+			return lineFormat(field.Type, field.Tag)
+		}
+	}
+	return nil
+}
+
+func decodeSections(c chunk, v reflect.Value) error {
+	sections := c.split(blankLinePattern)
+	fields := exportedFields(v.Type())
+
+	if len(sections) != len(fields) {
+		return c.errorf("expected %d sections, found %d", len(fields), len(sections))
+	}
+
+	for i, field := range fields {
+		if err := decodeValue(sections[i], v.FieldByIndex(field.Index), field.Tag); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func decodeValue(c chunk, v reflect.Value, tag reflect.StructTag) error {
+	switch v.Kind() {
+	case reflect.Slice:
+		return decodeSlice(c, v, tag)
+	case reflect.Struct:
+		return decodeStruct(c, v, tag)
+	case reflect.Pointer:
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return decodeValue(c, v.Elem(), tag)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		c = c.trim()
+		number, err := strconv.ParseInt(c.text, 10, v.Type().Bits())
+		if err != nil {
+			return c.errorf("invalid number %q", c.text)
+		}
+		v.SetInt(number)
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		c = c.trim()
+		number, err := strconv.ParseUint(c.text, 10, v.Type().Bits())
+		if err != nil {
+			return c.errorf("invalid number %q", c.text)
+		}
+		v.SetUint(number)
+		return nil
+	case reflect.String:
+		v.SetString(c.trim().text)
+		return nil
+	}
+	return c.errorf("unsupported type %s", v.Type())
+}
+
+func decodeSlice(c chunk, v reflect.Value, tag reflect.StructTag) error {
+	elemType := v.Type().Elem()
+
+	var elements []chunk
+	switch {
+	case blankLinePattern.MatchString(c.text):
+		elements = c.split(blankLinePattern)
+	case strings.Contains(c.text, "\n"):
+		elements = c.split(newlinePattern)
+	default:
+		elements = splitLine(c, elemType, tag)
+	}
+
+	slice := reflect.MakeSlice(v.Type(), len(elements), len(elements))
+	for i, element := range elements {
+		if err := decodeValue(element, slice.Index(i), tag); err != nil {
+			return err
+		}
+	}
+	v.Set(slice)
+
+	return nil
+}
+
+// The elements of a list on a single line
+func splitLine(c chunk, elemType reflect.Type, tag reflect.StructTag) []chunk {
+	if separator, ok := tag.Lookup("split"); ok {
+		return c.split(regexp.MustCompile(regexp.QuoteMeta(separator)))
+	}
+
+	var pattern *regexp.Regexp
+	switch elemType.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		pattern = integerPattern
+	case reflect.String:
+		pattern = wordPattern
+	default:
+		// A single struct (or nested slice) on its own line
+		return []chunk{c}
+	}
+
+	elements := []chunk{}
+	for _, match := range pattern.FindAllStringIndex(c.text, -1) {
+		elements = append(elements, c.slice(match[0], match[1]))
+	}
+	return elements
+}
+
+func decodeStruct(c chunk, v reflect.Value, tag reflect.StructTag) error {
+	c = c.trim()
+	fields := exportedFields(v.Type())
+
+	format := lineFormat(v.Type(), tag)
+	if format == nil {
+		return decodeFields(c, v, fields)
+	}
+
+	var pattern *regexp.Regexp
+	var err error
+	if template, ok := format.Lookup("pattern"); ok {
+		pattern, err = scanPattern(template)
+	} else {
+		pattern, err = regexp.Compile(format.Get("regex"))
+	}
+	if err != nil {
+		return c.errorf("%v", err)
+	}
+
+	match := pattern.FindStringSubmatchIndex(c.text)
+	if match == nil {
+		return c.errorf("%q does not match %q", c.text, pattern)
+	}
+
+	named := false
+	for _, name := range pattern.SubexpNames() {
+		named = named || name != ""
+	}
+
+	if !named && pattern.NumSubexp() != len(fields) {
+		return c.errorf("format %q has %d groups but %s has %d fields", pattern, pattern.NumSubexp(), v.Type(), len(fields))
+	}
+
+	for i, field := range fields {
+		group := i + 1
+		if named {
+			group = pattern.SubexpIndex(field.Name)
+			if group == -1 {
+				group = pattern.SubexpIndex(strings.ToLower(field.Name))
+			}
+			if group == -1 {
+				continue
+			}
+		}
+		if match[2*group] == -1 {
+			continue
+		}
+		if err := decodeValue(c.slice(match[2*group], match[2*group+1]), v.FieldByIndex(field.Index), field.Tag); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Search for each field's text with its find tag
+func decodeFields(c chunk, v reflect.Value, fields []reflect.StructField) error {
+	for _, field := range fields {
+		find, ok := field.Tag.Lookup("find")
+		if !ok && hasFormat(field.Tag) {
+			// The field's own format searches the whole text
+			find, ok = "(?s).*", true
+		}
+		if !ok {
+			return c.errorf("field %s of %s needs a find tag (or the struct needs a pattern or regex)", field.Name, v.Type())
+		}
+
+		pattern, err := regexp.Compile(find)
+		if err != nil {
+			return c.errorf("field %s: %v", field.Name, err)
+		}
+
+		match := pattern.FindStringSubmatchIndex(c.text)
+		if match == nil {
+			return c.errorf("field %s: %q does not contain %q", field.Name, c.text, find)
+		}
+
+		start, end := match[0], match[1]
+		if len(match) > 2 {
+			start, end = match[2], match[3]
+		}
+		if start < 0 || end < 0 {
+			return c.errorf("field %s: the group in %q did not match %q", field.Name, find, c.text)
+		}
+
+		if err := decodeValue(c.slice(start, end), v.FieldByIndex(field.Index), field.Tag); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func MustUnmarshal(input string, v any) {
+	if err := Unmarshal(input, v); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,161 @@
+package parsing
+
+import (
+	"errors"
+	"os"
+	"slices"
+	"testing"
+)
+
+func readExample(path string) string {
+	input, err := os.ReadFile(path)
+
+	if err != nil {
+		panic("Couldn't find the example file!")
+	}
+
+	return string(input)
+}
+
+type point struct {
+	_ struct{} `regex:"X[+=](\\d+), Y[+=](\\d+)"`
+	X int
+	Y int
+}
+
+func TestUnmarshalSections(t *testing.T) {
+	var puzzle struct {
+		Workflows []struct {
+			_     struct{} `pattern:"%s{%s}"`
+			Name  string
+			Rules []string `split:","`
+		}
+		Parts []struct {
+			_          struct{} `pattern:"{x=%d,m=%d,a=%d,s=%d}"`
+			X, M, A, S int
+		}
+	}
+
+	err := Unmarshal(readExample("../../2023/day19/input/example.txt"), &puzzle)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(puzzle.Workflows) != 11 || len(puzzle.Parts) != 5 {
+		t.Errorf("Expected 11 workflows and 5 parts, got %v and %v", len(puzzle.Workflows), len(puzzle.Parts))
+	}
+
+	expected := []string{"a<2006:qkq", "m>2090:A", "rfg"}
+
+	if puzzle.Workflows[0].Name != "px" || !slices.Equal(puzzle.Workflows[0].Rules, expected) {
+		t.Errorf("Expected px %v, got %+v", expected, puzzle.Workflows[0])
+	}
+
+	total := 0
+	for _, part := range puzzle.Parts {
+		total += part.X + part.M + part.A + part.S
+	}
+
+	if total != 27957 {
+		t.Errorf("Expected %v, got %v", 27957, total)
+	}
+}
+
+func TestUnmarshalBlocks(t *testing.T) {
+	var machines []struct {
+		A     point `find:"Button A: (.*)"`
+		B     point `find:"Button B: (.*)"`
+		Prize point `find:"Prize: (.*)"`
+	}
+
+	err := Unmarshal(readExample("../../2024/day13/input/example.txt"), &machines)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(machines) != 4 {
+		t.Fatalf("Expected %v, got %v", 4, len(machines))
+	}
+
+	last := machines[3]
+	if last.A.X != 69 || last.A.Y != 23 || last.B.X != 27 || last.B.Y != 71 || last.Prize.X != 18641 || last.Prize.Y != 10279 {
+		t.Errorf("Unexpected machine %+v", last)
+	}
+}
+
+func TestUnmarshalLines(t *testing.T) {
+	var reports [][]int
+
+	err := Unmarshal("7 6 4 2 1\n1 2 7 8 9\n", &reports)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(reports) != 2 || !slices.Equal(reports[1], []int{1, 2, 7, 8, 9}) {
+		t.Errorf("Unexpected reports %v", reports)
+	}
+
+	var robots []struct {
+		Position point `regex:"p=(?P<X>-?\\d+),(?P<Y>-?\\d+)"`
+		Velocity point `find:"v=(\\S+)" regex:"(?P<x>-?\\d+),(?P<y>-?\\d+)"`
+	}
+
+	err = Unmarshal("p=0,4 v=3,-3\np=6,3 v=-1,-3", &robots)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if robots[1].Position.X != 6 || robots[1].Velocity.X != -1 || robots[1].Velocity.Y != -3 {
+		t.Errorf("Unexpected robots %+v", robots)
+	}
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	var rules []struct {
+		_      struct{} `pattern:"%s|%s"`
+		Before int
+		After  int
+	}
+
+	err := Unmarshal("47|53\n97|1x3\n", &rules)
+
+	var unmarshalError *UnmarshalError
+	if !errors.As(err, &unmarshalError) {
+		t.Fatalf("Expected an UnmarshalError, got %v", err)
+	}
+
+	if unmarshalError.Line != 2 || unmarshalError.Column != 4 {
+		t.Errorf("Expected line 2 column 4, got %v", err)
+	}
+
+	var sections struct {
+		A []int
+		B []int
+	}
+
+	err = Unmarshal("1\n2\n\n3\n\n4", &sections)
+
+	if err == nil {
+		t.Errorf("Expected an error for the wrong number of sections")
+	}
+
+	var optional []struct {
+		X string `find:"(x)?y"`
+	}
+
+	err = Unmarshal("y", &optional)
+
+	if !errors.As(err, &unmarshalError) {
+		t.Errorf("Expected an UnmarshalError for a group that didn't match, got %v", err)
+	}
+
+	err = Unmarshal("1 2", sections)
+
+	if err == nil {
+		t.Errorf("Expected an error for a non-pointer")
+	}
+}
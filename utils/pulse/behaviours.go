@@ -0,0 +1,46 @@
+package pulse
+
+// Behaviours for networks of high (true) and low (false) pulses
+
+// Sends every signal on unchanged
+type Broadcast[S any] struct{}
+
+func (b *Broadcast[S]) Receive(from string, signal S) (S, bool) {
+	return signal, true
+}
+
+// Ignores high pulses, and flips on or off for a low pulse (sending high when it turns on)
+type FlipFlop struct {
+	On bool
+}
+
+func (f *FlipFlop) Receive(from string, signal bool) (bool, bool) {
+	if signal {
+		return false, false
+	}
+	f.On = !f.On
+	return f.On, true
+}
+
+// Remembers the last pulse from each input - sends low if they are all high, otherwise high
+type Conjunction struct {
+	Memory map[string]bool
+}
+
+func NewConjunction() *Conjunction {
+	return &Conjunction{Memory: map[string]bool{}}
+}
+
+func (c *Conjunction) AddInput(name string) {
+	c.Memory[name] = false
+}
+
+func (c *Conjunction) Receive(from string, signal bool) (bool, bool) {
+	c.Memory[from] = signal
+	for _, high := range c.Memory {
+		if !high {
+			return true, true
+		}
+	}
+	return false, true
+}
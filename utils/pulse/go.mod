@@ -0,0 +1,3 @@
+module github.com/jmugliston/aoc/pulse
+
+go 1.23
@@ -0,0 +1,201 @@
+package pulse
+
+import (
+	"fmt"
+	"io"
+	"slices"
+)
+
+// A network of nodes that pass signals to each other, like the modules in 2023 day 20
+// Messages are delivered in the order they are sent (first in, first out)
+
+type Message[S any] struct {
+	From   string
+	To     string
+	Signal S
+}
+
+// What a node does with a signal - returns the signal to send to every output, if any
+type Behaviour[S any] interface {
+	Receive(from string, signal S) (S, bool)
+}
+
+// Behaviours that need to know their inputs (e.g. to remember the last signal from each)
+type InputTracker interface {
+	AddInput(name string)
+}
+
+type Node[S any] struct {
+	Name string
+	// nil for a node that only receives signals
+	Behaviour Behaviour[S]
+	Inputs    []string
+	Outputs   []string
+}
+
+// A message delivered to a watched node, and the round it arrived in
+type Received[S any] struct {
+	Round  int
+	From   string
+	Signal S
+}
+
+type Network[S comparable] struct {
+	nodes map[string]*Node[S]
+	// Number of times Send has been called
+	Round int
+	// Write every message delivered here
+	Trace io.Writer
+	// How to show a signal in the trace (fmt.Sprint by default)
+	FormatSignal func(S) string
+	histories    map[string][]Received[S]
+}
+
+func New[S comparable]() *Network[S] {
+	return &Network[S]{nodes: map[string]*Node[S]{}, histories: map[string][]Received[S]{}}
+}
+
+func (n *Network[S]) node(name string) *Node[S] {
+	if _, ok := n.nodes[name]; !ok {
+		n.nodes[name] = &Node[S]{Name: name}
+	}
+	return n.nodes[name]
+}
+
+// Add a node and connect it to its outputs (which don't need to have been added yet)
+func (n *Network[S]) Add(name string, behaviour Behaviour[S], outputs ...string) {
+	node := n.node(name)
+	node.Behaviour = behaviour
+	node.Outputs = append(node.Outputs, outputs...)
+
+	if tracker, ok := behaviour.(InputTracker); ok {
+		for _, input := range node.Inputs {
+			tracker.AddInput(input)
+		}
+	}
+
+	for _, output := range outputs {
+		next := n.node(output)
+		next.Inputs = append(next.Inputs, name)
+		if tracker, ok := next.Behaviour.(InputTracker); ok {
+			tracker.AddInput(name)
+		}
+	}
+}
+
+func (n *Network[S]) Node(name string) (*Node[S], bool) {
+	node, ok := n.nodes[name]
+	return node, ok
+}
+
+// Every node name, sorted
+func (n *Network[S]) Names() []string {
+	names := make([]string, 0, len(n.nodes))
+	for name := range n.nodes {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// Keep a history of every message a node receives
+func (n *Network[S]) Watch(names ...string) {
+	for _, name := range names {
+		if _, ok := n.histories[name]; !ok {
+			n.histories[name] = []Received[S]{}
+		}
+	}
+}
+
+func (n *Network[S]) History(name string) []Received[S] {
+	return n.histories[name]
+}
+
+// Send a signal to a node (e.g. from a button), and deliver messages until the network settles
+// Returns the number of messages delivered with each signal (including the first)
+func (n *Network[S]) Send(from string, to string, signal S) map[S]int {
+	n.Round++
+	counts := map[S]int{}
+
+	queue := []Message[S]{{From: from, To: to, Signal: signal}}
+	for len(queue) > 0 {
+		message := queue[0]
+		queue = queue[1:]
+
+		counts[message.Signal]++
+
+		if n.Trace != nil {
+			fmt.Fprintf(n.Trace, "%s -%s-> %s\n", message.From, n.format(message.Signal), message.To)
+		}
+
+		if history, ok := n.histories[message.To]; ok {
+			n.histories[message.To] = append(history, Received[S]{Round: n.Round, From: message.From, Signal: message.Signal})
+		}
+
+		node, ok := n.nodes[message.To]
+		if !ok || node.Behaviour == nil {
+			continue
+		}
+
+		next, ok := node.Behaviour.Receive(message.From, message.Signal)
+		if !ok {
+			continue
+		}
+
+		for _, output := range node.Outputs {
+			queue = append(queue, Message[S]{From: node.Name, To: output, Signal: next})
+		}
+	}
+
+	return counts
+}
+
+func (n *Network[S]) format(signal S) string {
+	if n.FormatSignal != nil {
+		return n.FormatSignal(signal)
+	}
+	return fmt.Sprint(signal)
+}
+
+// The rounds in which a watched node received a signal
+func (n *Network[S]) roundsReceived(name string, signal S) []int {
+	rounds := []int{}
+	for _, received := range n.histories[name] {
+		if received.Signal == signal && (len(rounds) == 0 || rounds[len(rounds)-1] != received.Round) {
+			rounds = append(rounds, received.Round)
+		}
+	}
+	return rounds
+}
+
+// How many rounds apart a watched node receives a signal, once it has happened twice
+func (n *Network[S]) CycleLength(name string, signal S) (int, bool) {
+	rounds := n.roundsReceived(name, signal)
+	if len(rounds) < 2 {
+		return 0, false
+	}
+	return rounds[1] - rounds[0], true
+}
+
+// Keep sending a signal until each node has received a signal twice, and return how
+// many rounds apart each one receives it - gives up with an error after limit rounds
+func (n *Network[S]) FindCycles(from string, to string, signal S, nodes []string, received S, limit int) (map[string]int, error) {
+	n.Watch(nodes...)
+
+	cycles := map[string]int{}
+	for i := 0; i < limit; i++ {
+		n.Send(from, to, signal)
+
+		for _, name := range nodes {
+			if length, ok := n.CycleLength(name, received); ok {
+				cycles[name] = length
+			}
+		}
+
+		if len(cycles) == len(nodes) {
+			return cycles, nil
+		}
+	}
+
+	return nil, fmt.Errorf("Found %d of %d cycles after %d rounds", len(cycles), len(nodes), limit)
+}
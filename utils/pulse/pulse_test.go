@@ -0,0 +1,123 @@
+package pulse
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func readExample(path string) string {
+	input, err := os.ReadFile(path)
+
+	if err != nil {
+		panic("Couldn't find the example file!")
+	}
+
+	return strings.TrimSpace(string(input))
+}
+
+// 2023 day 20 - "%a -> inv, con"
+func parseModules(input string) *Network[bool] {
+	n := New[bool]()
+	n.FormatSignal = func(high bool) string {
+		if high {
+			return "high"
+		}
+		return "low"
+	}
+
+	for _, line := range strings.Split(input, "\n") {
+		parts := strings.Split(line, " -> ")
+		outputs := strings.Split(parts[1], ", ")
+		name := strings.TrimLeft(parts[0], "%&")
+
+		switch parts[0][0] {
+		case '%':
+			n.Add(name, &FlipFlop{}, outputs...)
+		case '&':
+			n.Add(name, NewConjunction(), outputs...)
+		default:
+			n.Add(name, &Broadcast[bool]{}, outputs...)
+		}
+	}
+
+	return n
+}
+
+func TestSend(t *testing.T) {
+	tests := map[string]int{
+		"../../2023/day20/input/example.txt":  32000000,
+		"../../2023/day20/input/example2.txt": 11687500,
+	}
+
+	for path, expected := range tests {
+		n := parseModules(readExample(path))
+
+		high, low := 0, 0
+		for i := 0; i < 1000; i++ {
+			counts := n.Send("button", "broadcaster", false)
+			high += counts[true]
+			low += counts[false]
+		}
+
+		if high*low != expected {
+			t.Errorf("Expected %v, got %v", expected, high*low)
+		}
+	}
+}
+
+func TestTrace(t *testing.T) {
+	n := parseModules(readExample("../../2023/day20/input/example.txt"))
+
+	var trace strings.Builder
+	n.Trace = &trace
+
+	n.Send("button", "broadcaster", false)
+
+	expected := `button -low-> broadcaster
+broadcaster -low-> a
+broadcaster -low-> b
+broadcaster -low-> c
+a -high-> b
+b -high-> c
+c -high-> inv
+inv -low-> a
+a -low-> b
+b -low-> c
+c -low-> inv
+inv -high-> a
+`
+
+	if trace.String() != expected {
+		t.Errorf("Expected %v, got %v", expected, trace.String())
+	}
+
+	if node, _ := n.Node("inv"); len(node.Inputs) != 1 || node.Inputs[0] != "c" {
+		t.Errorf("Expected inv to have input c, got %v", node.Inputs)
+	}
+}
+
+func TestFindCycles(t *testing.T) {
+	n := parseModules(readExample("../../2023/day20/input/example2.txt"))
+
+	// The output gets a low pulse on every other press
+	cycles, err := n.FindCycles("button", "broadcaster", false, []string{"output"}, false, 100)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if cycles["output"] != 2 || n.Round != 3 {
+		t.Errorf("Expected %v after %v rounds, got %v after %v", 2, 3, cycles["output"], n.Round)
+	}
+
+	history := n.History("output")
+
+	if len(history) == 0 || history[0].Round != 1 || history[0].From != "con" {
+		t.Errorf("Unexpected history %v", history)
+	}
+
+	if _, err := n.FindCycles("button", "broadcaster", false, []string{"nowhere"}, false, 10); err == nil {
+		t.Errorf("Expected an error for a node that never gets a signal")
+	}
+}
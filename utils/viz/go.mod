@@ -0,0 +1,11 @@
+module github.com/jmugliston/aoc/viz
+
+replace github.com/jmugliston/aoc/grid => ../grid
+
+replace github.com/jmugliston/aoc/utils => ../general
+
+go 1.23
+
+require github.com/jmugliston/aoc/grid v0.0.0-00010101000000-000000000000
+
+require github.com/jmugliston/aoc/utils v0.0.0-00010101000000-000000000000 // indirect
@@ -0,0 +1,194 @@
+package viz
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/png"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/jmugliston/aoc/grid"
+)
+
+var ErrTooManyColours = errors.New("Too many colours for a paletted image (the limit is 254 plus background and highlight)")
+
+// How to draw frames as images (captions aren't drawn)
+type ImageOptions struct {
+	// Colours for cell values (anything missing is drawn in the background colour)
+	Palette    map[string]color.Color
+	Background color.Color
+	// Colour for highlighted points
+	Highlight color.Color
+	// Width and height of each cell in pixels
+	Scale int
+	// Time between the frames of an animated GIF, in hundredths of a second
+	Delay int
+}
+
+var pipe = color.RGBA{0x66, 0x66, 0x66, 0xff}
+
+// Walls and robots (#) are green, and tiles inside a loop (I) are blue
+// Pipes (2023 day 10) are grey, so the highlighted loop stands out from the rest of the maze
+var DefaultImageOptions = ImageOptions{
+	Palette: map[string]color.Color{
+		"#": color.RGBA{0x00, 0xcc, 0x00, 0xff},
+		"I": color.RGBA{0x33, 0x66, 0xff, 0xff},
+		"|": pipe, "-": pipe, "L": pipe, "J": pipe, "7": pipe, "F": pipe, "S": pipe,
+	},
+	Background: color.Black,
+	Highlight:  color.RGBA{0xff, 0xcc, 0x66, 0xff},
+	Scale:      4,
+	Delay:      10,
+}
+
+// A frame from any grid, using fmt.Sprint for each cell (e.g. a NumberGrid or an automaton generation)
+func Snapshot[T any](g [][]T, caption string, highlight ...grid.Point) Frame {
+	cells := make(grid.StringGrid, len(g))
+	for y, row := range g {
+		cells[y] = make([]string, len(row))
+		for x, value := range row {
+			cells[y][x] = fmt.Sprint(value)
+		}
+	}
+	return Frame{Grid: cells, Highlight: highlight, Caption: caption}
+}
+
+// Parse colours for cell values, e.g. "#=00cc00,O=ffffff" (a leading # on the colour is optional)
+func ParsePalette(input string) (map[string]color.Color, error) {
+	palette := map[string]color.Color{}
+
+	for _, entry := range strings.Split(input, ",") {
+		if strings.TrimSpace(entry) == "" {
+			continue
+		}
+
+		value, hex, ok := strings.Cut(entry, "=")
+		hex = strings.TrimPrefix(strings.TrimSpace(hex), "#")
+		if !ok || len(hex) != 6 {
+			return nil, fmt.Errorf("Invalid palette entry %q (expected value=rrggbb)", entry)
+		}
+
+		rgb, err := strconv.ParseUint(hex, 16, 32)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid palette entry %q: %w", entry, err)
+		}
+
+		palette[value] = color.RGBA{uint8(rgb >> 16), uint8(rgb >> 8), uint8(rgb), 0xff}
+	}
+
+	return palette, nil
+}
+
+// The colours of an image, with the index for each cell value
+// The background is always first and the highlight second
+func (o ImageOptions) colours() (color.Palette, map[string]uint8, error) {
+	values := make([]string, 0, len(o.Palette))
+	for value := range o.Palette {
+		values = append(values, value)
+	}
+	slices.Sort(values)
+
+	if len(values) > 254 {
+		return nil, nil, ErrTooManyColours
+	}
+
+	background, highlight := o.Background, o.Highlight
+	if background == nil {
+		background = color.Black
+	}
+	if highlight == nil {
+		highlight = color.White
+	}
+
+	colours := color.Palette{background, highlight}
+	indices := map[string]uint8{}
+	for _, value := range values {
+		indices[value] = uint8(len(colours))
+		colours = append(colours, o.Palette[value])
+	}
+
+	return colours, indices, nil
+}
+
+func (o ImageOptions) draw(frame Frame, colours color.Palette, indices map[string]uint8) *image.Paletted {
+	scale := max(o.Scale, 1)
+
+	width := 0
+	for _, row := range frame.Grid {
+		width = max(width, len(row))
+	}
+
+	img := image.NewPaletted(image.Rect(0, 0, width*scale, len(frame.Grid)*scale), colours)
+
+	fill := func(p grid.Point, index uint8) {
+		for y := p.Y * scale; y < (p.Y+1)*scale; y++ {
+			for x := p.X * scale; x < (p.X+1)*scale; x++ {
+				img.SetColorIndex(x, y, index)
+			}
+		}
+	}
+
+	for y, row := range frame.Grid {
+		for x, cell := range row {
+			if index, ok := indices[cell]; ok {
+				fill(grid.Point{X: x, Y: y}, index)
+			}
+		}
+	}
+
+	for _, p := range frame.Highlight {
+		if p.Y >= 0 && p.Y < len(frame.Grid) && p.X >= 0 && p.X < len(frame.Grid[p.Y]) {
+			fill(p, 1)
+		}
+	}
+
+	return img
+}
+
+// Draw a frame, with each cell as a square of Scale pixels
+func (o ImageOptions) Image(frame Frame) (*image.Paletted, error) {
+	colours, indices, err := o.colours()
+	if err != nil {
+		return nil, err
+	}
+	return o.draw(frame, colours, indices), nil
+}
+
+// Write a frame as a PNG image
+func WritePNG(w io.Writer, frame Frame, options ImageOptions) error {
+	img, err := options.Image(frame)
+	if err != nil {
+		return err
+	}
+	return png.Encode(w, img)
+}
+
+// Write the frames as an animated GIF that loops forever
+// Frames can be different sizes, and are drawn from the top left
+func WriteGIF(w io.Writer, frames []Frame, options ImageOptions) error {
+	if len(frames) == 0 {
+		return errors.New("No frames to write")
+	}
+
+	colours, indices, err := options.colours()
+	if err != nil {
+		return err
+	}
+
+	animation := &gif.GIF{}
+	for _, frame := range frames {
+		img := options.draw(frame, colours, indices)
+		animation.Image = append(animation.Image, img)
+		animation.Delay = append(animation.Delay, options.Delay)
+		animation.Config.Width = max(animation.Config.Width, img.Bounds().Dx())
+		animation.Config.Height = max(animation.Config.Height, img.Bounds().Dy())
+	}
+	animation.Config.ColorModel = colours
+
+	return gif.EncodeAll(w, animation)
+}
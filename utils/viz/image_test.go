@@ -0,0 +1,100 @@
+package viz
+
+import (
+	"bytes"
+	"image/color"
+	"image/gif"
+	"image/png"
+	"testing"
+
+	"github.com/jmugliston/aoc/grid"
+)
+
+func TestImage(t *testing.T) {
+	options := ImageOptions{
+		Palette:    map[string]color.Color{"#": color.White},
+		Background: color.Black,
+		Highlight:  color.RGBA{0xff, 0, 0, 0xff},
+		Scale:      2,
+	}
+
+	img, err := options.Image(Frame{Grid: grid.Parse("#.\n.."), Highlight: []grid.Point{{X: 1, Y: 1}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if img.Bounds().Dx() != 4 || img.Bounds().Dy() != 4 {
+		t.Errorf("Expected %v, got %v", "4x4", img.Bounds())
+	}
+
+	tests := []struct {
+		x, y     int
+		expected color.Color
+	}{
+		{0, 0, color.White},
+		{1, 1, color.White},
+		{2, 0, color.Black},
+		{3, 3, color.RGBA{0xff, 0, 0, 0xff}},
+	}
+
+	for _, test := range tests {
+		r1, g1, b1, _ := img.At(test.x, test.y).RGBA()
+		r2, g2, b2, _ := test.expected.RGBA()
+		if r1 != r2 || g1 != g2 || b1 != b2 {
+			t.Errorf("Expected %v at %v,%v, got %v", test.expected, test.x, test.y, img.At(test.x, test.y))
+		}
+	}
+}
+
+func TestWritePNG(t *testing.T) {
+	var buffer bytes.Buffer
+
+	if err := WritePNG(&buffer, Snapshot([][]int{{1, 2, 3}}, ""), DefaultImageOptions); err != nil {
+		t.Fatal(err)
+	}
+
+	img, err := png.Decode(&buffer)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if img.Bounds().Dx() != 12 || img.Bounds().Dy() != 4 {
+		t.Errorf("Expected %v, got %v", "12x4", img.Bounds())
+	}
+}
+
+func TestWriteGIF(t *testing.T) {
+	var buffer bytes.Buffer
+
+	if err := WriteGIF(&buffer, exampleFrames(), DefaultImageOptions); err != nil {
+		t.Fatal(err)
+	}
+
+	animation, err := gif.DecodeAll(&buffer)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(animation.Image) != 3 {
+		t.Errorf("Expected %v, got %v", 3, len(animation.Image))
+	}
+
+	if err := WriteGIF(&buffer, []Frame{}, DefaultImageOptions); err == nil {
+		t.Errorf("Expected an error with no frames")
+	}
+}
+
+func TestParsePalette(t *testing.T) {
+	palette, err := ParsePalette("#=00cc00,O=#ffffff")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if palette["#"] != (color.RGBA{0x00, 0xcc, 0x00, 0xff}) || palette["O"] != (color.RGBA{0xff, 0xff, 0xff, 0xff}) {
+		t.Errorf("Expected %v, got %v", "#=00cc00,O=ffffff", palette)
+	}
+
+	if _, err := ParsePalette("#=green"); err == nil {
+		t.Errorf("Expected an error for an invalid colour")
+	}
+}
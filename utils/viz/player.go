@@ -0,0 +1,96 @@
+package viz
+
+import (
+	"fmt"
+	"io"
+	"time"
+)
+
+const (
+	clearScreen = "\x1b[H\x1b[2J"
+	maxSpeed    = 1000
+)
+
+// Plays frames in the terminal, one after another
+//
+// Keys: space pauses, n/p step forwards and backwards, +/- change the speed and q quits
+type Player struct {
+	Frames []Frame
+	Style  Style
+	// Frames per second
+	Speed  int
+	Paused bool
+	Out    io.Writer
+}
+
+func NewPlayer(frames []Frame, out io.Writer) *Player {
+	return &Player{Frames: frames, Style: DefaultStyle, Speed: 10, Out: out}
+}
+
+func (p *Player) draw(i int) {
+	state := "playing"
+	if p.Paused {
+		state = "paused"
+	}
+	fmt.Fprint(p.Out, clearScreen)
+	fmt.Fprint(p.Out, p.Style.Render(p.Frames[i]))
+	fmt.Fprintf(p.Out, "frame %d/%d, %d fps, %s - [space] pause [n/p] step [+/-] speed [q] quit\n", i+1, len(p.Frames), p.Speed, state)
+}
+
+// Play until the last frame, or until q is pressed
+// With keys, the player waits on the last frame instead of stopping (a nil channel plays straight through)
+func (p *Player) Play(keys <-chan rune) {
+	if len(p.Frames) == 0 {
+		return
+	}
+
+	p.Speed = max(1, min(p.Speed, maxSpeed))
+	interactive := keys != nil
+
+	i := 0
+	for {
+		if i == len(p.Frames)-1 && !p.Paused {
+			if !interactive {
+				p.draw(i)
+				return
+			}
+			p.Paused = true
+		}
+
+		p.draw(i)
+
+		var tick <-chan time.Time
+		if !p.Paused {
+			tick = time.After(time.Second / time.Duration(p.Speed))
+		}
+
+		select {
+		case <-tick:
+			i++
+		case key, ok := <-keys:
+			if !ok {
+				// No more input, so play to the end
+				keys = nil
+				interactive = false
+				p.Paused = false
+				continue
+			}
+			switch key {
+			case ' ':
+				p.Paused = !p.Paused
+			case 'n':
+				p.Paused = true
+				i = min(i+1, len(p.Frames)-1)
+			case 'p':
+				p.Paused = true
+				i = max(i-1, 0)
+			case '+', '=':
+				p.Speed = min(p.Speed*2, maxSpeed)
+			case '-':
+				p.Speed = max(p.Speed/2, 1)
+			case 'q':
+				return
+			}
+		}
+	}
+}
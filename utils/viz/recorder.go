@@ -0,0 +1,51 @@
+package viz
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/jmugliston/aoc/grid"
+)
+
+// Writes frames as JSON lines, so a solution running in its own process can hand them to the player
+// A nil recorder ignores everything, so solutions can always record
+type Recorder struct {
+	encoder *json.Encoder
+	Frames  int
+}
+
+func NewRecorder(w io.Writer) *Recorder {
+	return &Recorder{encoder: json.NewEncoder(w)}
+}
+
+// Record a frame (the grid is written straight away, so it can be changed afterwards)
+func (r *Recorder) Record(g grid.StringGrid, caption string, highlight ...grid.Point) error {
+	if r == nil {
+		return nil
+	}
+	r.Frames++
+	return r.encoder.Encode(Frame{Grid: g, Highlight: highlight, Caption: caption})
+}
+
+// Read every frame written by a recorder
+func Decode(r io.Reader) ([]Frame, error) {
+	frames := []Frame{}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 1024*1024), 64*1024*1024)
+
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var frame Frame
+		if err := json.Unmarshal(scanner.Bytes(), &frame); err != nil {
+			return nil, fmt.Errorf("Invalid frame on line %d: %w", line, err)
+		}
+		frames = append(frames, frame)
+	}
+
+	return frames, scanner.Err()
+}
@@ -0,0 +1,43 @@
+package viz
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+)
+
+func stty(in *os.File, args ...string) (string, error) {
+	cmd := exec.Command("stty", args...)
+	cmd.Stdin = in
+	out, err := cmd.Output()
+	return strings.TrimSpace(string(out)), err
+}
+
+// Read single key presses from a terminal, without waiting for enter
+// Returns a function to put the terminal back how it was
+// If in isn't a terminal there are no keys (and the player runs straight through)
+func Keys(in *os.File) (<-chan rune, func()) {
+	saved, err := stty(in, "-g")
+	if err != nil {
+		return nil, func() {}
+	}
+	if _, err := stty(in, "-icanon", "-echo", "min", "1"); err != nil {
+		return nil, func() {}
+	}
+
+	keys := make(chan rune)
+	go func() {
+		buffer := make([]byte, 1)
+		for {
+			if _, err := in.Read(buffer); err != nil {
+				close(keys)
+				return
+			}
+			keys <- rune(buffer[0])
+		}
+	}()
+
+	return keys, func() {
+		stty(in, saved)
+	}
+}
@@ -0,0 +1,77 @@
+package viz
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jmugliston/aoc/grid"
+)
+
+// A snapshot of a solution to play back in the terminal
+type Frame struct {
+	Grid      grid.StringGrid `json:"grid"`
+	Highlight []grid.Point    `json:"highlight,omitempty"`
+	Caption   string          `json:"caption,omitempty"`
+}
+
+// ANSI foreground colours - https://en.wikipedia.org/wiki/ANSI_escape_code#Colors
+type Colour int
+
+const (
+	Default Colour = 0
+	Black   Colour = iota + 29
+	Red
+	Green
+	Yellow
+	Blue
+	Magenta
+	Cyan
+	White
+)
+
+// Colour some text (the default colour leaves it unchanged)
+func (c Colour) Paint(s string) string {
+	if c == Default {
+		return s
+	}
+	return fmt.Sprintf("\x1b[%dm%s\x1b[0m", c, s)
+}
+
+// How to colour the cells of a frame
+type Style struct {
+	// Colours for cell values (anything missing is left uncoloured)
+	Palette map[string]Colour
+	// Colour for highlighted points, which are also drawn in bold
+	Highlight Colour
+}
+
+var DefaultStyle = Style{
+	Palette:   map[string]Colour{"#": Green},
+	Highlight: Yellow,
+}
+
+// Draw a frame as coloured text, with the caption underneath
+func (s Style) Render(frame Frame) string {
+	highlighted := map[grid.Point]bool{}
+	for _, p := range frame.Highlight {
+		highlighted[p] = true
+	}
+
+	var output strings.Builder
+	for y, row := range frame.Grid {
+		for x, cell := range row {
+			if highlighted[grid.Point{X: x, Y: y}] {
+				output.WriteString(fmt.Sprintf("\x1b[1;%dm%s\x1b[0m", s.Highlight, cell))
+			} else {
+				output.WriteString(s.Palette[cell].Paint(cell))
+			}
+		}
+		output.WriteString("\n")
+	}
+
+	if frame.Caption != "" {
+		output.WriteString(frame.Caption + "\n")
+	}
+
+	return output.String()
+}
@@ -0,0 +1,109 @@
+package viz
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/jmugliston/aoc/grid"
+)
+
+func exampleFrames() []Frame {
+	frames := []Frame{}
+	for i := 0; i < 3; i++ {
+		g := grid.InitialiseStringGrid(3, 1, ".")
+		g[0][i] = "#"
+		frames = append(frames, Frame{Grid: g, Highlight: []grid.Point{{X: i, Y: 0}}, Caption: "step"})
+	}
+	return frames
+}
+
+func TestRender(t *testing.T) {
+	style := Style{Palette: map[string]Colour{"#": Red}, Highlight: Yellow}
+
+	frame := Frame{
+		Grid:      grid.StringGrid{{"#", ".", "O"}},
+		Highlight: []grid.Point{{X: 2, Y: 0}},
+		Caption:   "Second 1",
+	}
+
+	expected := "\x1b[31m#\x1b[0m.\x1b[1;33mO\x1b[0m\nSecond 1\n"
+	result := style.Render(frame)
+
+	if result != expected {
+		t.Errorf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestRecorder(t *testing.T) {
+	var buffer bytes.Buffer
+	recorder := NewRecorder(&buffer)
+
+	g := grid.Parse("#.\n.#")
+	recorder.Record(g, "first", grid.Point{X: 1, Y: 1})
+	g[0][0] = "."
+	recorder.Record(g, "second")
+
+	frames, err := Decode(&buffer)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(frames) != 2 || recorder.Frames != 2 {
+		t.Fatalf("Expected 2 frames, got %v", len(frames))
+	}
+
+	if frames[0].Grid[0][0] != "#" || frames[1].Grid[0][0] != "." {
+		t.Errorf("Expected each frame to keep its own grid, got %v", frames)
+	}
+
+	if frames[0].Caption != "first" || len(frames[0].Highlight) != 1 || frames[0].Highlight[0] != (grid.Point{X: 1, Y: 1}) {
+		t.Errorf("Expected %v, got %v", "first", frames[0])
+	}
+
+	var nothing *Recorder
+	if err := nothing.Record(g, "ignored"); err != nil {
+		t.Errorf("Expected a nil recorder to ignore frames, got %v", err)
+	}
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	_, err := Decode(strings.NewReader("{\"grid\":[[\"#\"]]}\nnot a frame\n"))
+
+	if err == nil || !strings.Contains(err.Error(), "line 2") {
+		t.Errorf("Expected an error on line 2, got %v", err)
+	}
+}
+
+func TestPlayThrough(t *testing.T) {
+	var out bytes.Buffer
+	player := NewPlayer(exampleFrames(), &out)
+	player.Speed = maxSpeed
+
+	player.Play(nil)
+
+	if strings.Count(out.String(), clearScreen) != 3 {
+		t.Errorf("Expected %v frames, got %v", 3, strings.Count(out.String(), clearScreen))
+	}
+}
+
+func TestPlayControls(t *testing.T) {
+	var out bytes.Buffer
+	player := NewPlayer(exampleFrames(), &out)
+	player.Paused = true
+
+	keys := make(chan rune, 10)
+	for _, key := range "nn-np+q" {
+		keys <- key
+	}
+
+	player.Play(keys)
+
+	draws := strings.Split(out.String(), clearScreen)
+	last := draws[len(draws)-1]
+
+	expected := "frame 2/3, 10 fps, paused"
+	if !strings.Contains(last, expected) {
+		t.Errorf("Expected %v, got %v", expected, last)
+	}
+}
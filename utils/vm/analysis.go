@@ -0,0 +1,202 @@
+package vm
+
+import (
+	"math/rand/v2"
+	"runtime"
+	"slices"
+	"sync"
+)
+
+// Tools for working out what a program does by running it many times
+
+// The output of one run, from a set of initial registers
+type Result struct {
+	Registers []int
+	Output    []int
+	Err       error
+}
+
+// A copy of the machine with its own registers and output, back at the start of the program
+// The program and instruction set are shared (they are never changed by running)
+func (m *Machine) Clone() *Machine {
+	clone := New(m.Instructions, m.RegisterNames, m.Program)
+	copy(clone.Registers, m.Registers)
+	clone.StepLimit = m.StepLimit
+	for address := range m.breakpoints {
+		clone.breakpoints[address] = true
+	}
+	return clone
+}
+
+// Run a copy of the machine for each set of initial registers, spread over every CPU
+// Results are in the same order as the inputs
+func (m *Machine) RunMany(inputs [][]int) []Result {
+	results := make([]Result, len(inputs))
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+
+	for range min(runtime.NumCPU(), len(inputs)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				clone := m.Clone()
+				clone.Reset(inputs[i]...)
+				err := clone.Run()
+				results[i] = Result{Registers: inputs[i], Output: clone.Output, Err: err}
+			}
+		}()
+	}
+
+	for i := range inputs {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return results
+}
+
+// The current registers, with one of them replaced by each value
+func (m *Machine) withRegister(register int, values []int) [][]int {
+	inputs := make([][]int, len(values))
+	for i, value := range values {
+		inputs[i] = slices.Clone(m.Registers)
+		inputs[i][register] = value
+	}
+	return inputs
+}
+
+// Run the program for every value of a register from start to end (exclusive),
+// keeping the other registers as they are
+func (m *Machine) RunRange(register int, start int, end int) []Result {
+	values := make([]int, 0, max(end-start, 0))
+	for value := start; value < end; value++ {
+		values = append(values, value)
+	}
+	return m.RunMany(m.withRegister(register, values))
+}
+
+func pow(base int, exponent int) int {
+	result := 1
+	for range exponent {
+		result *= base
+	}
+	return result
+}
+
+// Which digits of an input (in some base) change which values of the output
+// Influence[d][k] is true if changing digit d (0 is the least significant) changed output k
+type Influence [][]bool
+
+// Whether each output only depends on the digit in the same position and the ones above it
+// (like a program that outputs one value per digit, consuming the low digit each time)
+// When this holds, SearchBackward can find inputs one digit at a time
+func (inf Influence) DependsOnHigherDigits() bool {
+	for d, outputs := range inf {
+		for k, changed := range outputs {
+			if changed && k > d {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// The outputs changed by a digit
+func (inf Influence) Outputs(digit int) []int {
+	outputs := []int{}
+	for k, changed := range inf[digit] {
+		if changed {
+			outputs = append(outputs, k)
+		}
+	}
+	return outputs
+}
+
+// Estimate the Influence of each digit of a register by running the program on random
+// inputs with the given number of digits, then changing one digit at a time
+func (m *Machine) AnalyseDigits(register int, base int, digits int, samples int) Influence {
+	random := rand.New(rand.NewPCG(uint64(base), uint64(digits)))
+
+	low, high := pow(base, digits-1), pow(base, digits)
+
+	values := []int{}
+	for range samples {
+		value := low + random.IntN(high-low)
+		values = append(values, value)
+
+		for d := range digits {
+			place := pow(base, d)
+			digit := value / place % base
+			// Another digit, keeping the top digit non-zero so the length stays the same
+			other := (digit + 1 + random.IntN(base-1)) % base
+			if d == digits-1 && other == 0 {
+				other = digit
+			}
+			values = append(values, value+(other-digit)*place)
+		}
+	}
+
+	results := m.RunMany(m.withRegister(register, values))
+
+	inf := make(Influence, digits)
+	width := 0
+	for _, result := range results {
+		width = max(width, len(result.Output))
+	}
+	for d := range inf {
+		inf[d] = make([]bool, width)
+	}
+
+	for s := range samples {
+		original := results[s*(digits+1)].Output
+		for d := range digits {
+			changed := results[s*(digits+1)+1+d].Output
+			for k := range width {
+				if k >= len(original) || k >= len(changed) || original[k] != changed[k] {
+					inf[d][k] = true
+				}
+			}
+		}
+	}
+
+	return inf
+}
+
+// Find the smallest value of a register that makes the program output target, building
+// it one digit at a time from the most significant, with one digit for each output value
+// This only works when each output depends on its digit and the ones above it (see AnalyseDigits)
+func (m *Machine) SearchBackward(register int, base int, target []int) (int, bool) {
+	var search func(value int, digit int) (int, bool)
+
+	search = func(value int, digit int) (int, bool) {
+		if digit < 0 {
+			return value, true
+		}
+
+		place := pow(base, digit)
+		candidates := make([]int, base)
+		for i := range candidates {
+			candidates[i] = value + i*place
+		}
+
+		// Try each digit, smallest first, checking the outputs from this digit up
+		for i, result := range m.RunMany(m.withRegister(register, candidates)) {
+			if result.Err != nil || len(result.Output) != len(target) {
+				continue
+			}
+			if !slices.Equal(result.Output[digit:], target[digit:]) {
+				continue
+			}
+			if found, ok := search(candidates[i], digit-1); ok {
+				return found, true
+			}
+		}
+
+		return 0, false
+	}
+
+	return search(0, len(target)-1)
+}
@@ -0,0 +1,85 @@
+package vm
+
+import (
+	"slices"
+	"testing"
+)
+
+// Part of 2024 day 17 - enough to run the second example
+var chronospatial = InstructionSet{
+	0: {Name: "adv", Operands: []OperandMode{Literal}, Execute: func(m *Machine, ops []int) { m.Registers[0] >>= ops[0] }},
+	3: {Name: "jnz", Operands: []OperandMode{Literal}, Execute: func(m *Machine, ops []int) {
+		if m.Registers[0] != 0 {
+			m.IP = ops[0]
+		}
+	}},
+	5: {Name: "out", Operands: []OperandMode{Register}, Execute: func(m *Machine, ops []int) { m.Out(ops[0] % 8) }},
+}
+
+func TestRunRange(t *testing.T) {
+	// adv 3, out A, jnz 0
+	m := New(chronospatial, []string{"A"}, []int{0, 3, 5, 0, 3, 0})
+
+	results := m.RunRange(0, 8, 16)
+
+	if len(results) != 8 {
+		t.Fatalf("Expected %v, got %v", 8, len(results))
+	}
+
+	for i, result := range results {
+		expected := []int{1, 0}
+		if result.Registers[0] != 8+i || !slices.Equal(result.Output, expected) {
+			t.Errorf("Expected %v, got %v", expected, result.Output)
+		}
+	}
+
+	// The original machine is untouched
+	if m.Steps != 0 || m.Output != nil {
+		t.Errorf("Expected the machine not to have run")
+	}
+}
+
+func TestAnalyseDigits(t *testing.T) {
+	m := New(chronospatial, []string{"A"}, []int{0, 3, 5, 0, 3, 0})
+
+	influence := m.AnalyseDigits(0, 8, 6, 20)
+
+	if !influence.DependsOnHigherDigits() {
+		t.Errorf("Expected each output to depend on higher digits, got %v", influence)
+	}
+
+	// Output k is digit k + 1, so the lowest digit is never used
+	if len(influence.Outputs(0)) != 0 || !slices.Equal(influence.Outputs(3), []int{2}) {
+		t.Errorf("Unexpected influence %v", influence)
+	}
+
+	// Output the lowest digit twice
+	m = New(chronospatial, []string{"A"}, []int{5, 0, 5, 0})
+
+	if m.AnalyseDigits(0, 8, 2, 20).DependsOnHigherDigits() {
+		t.Errorf("Expected the second output to depend on a lower digit")
+	}
+}
+
+func TestSearchBackward(t *testing.T) {
+	// 2024 day 17 - find the A that makes the program output itself
+	program := []int{0, 3, 5, 0, 3, 0}
+	m := New(chronospatial, []string{"A"}, program)
+
+	result, ok := m.SearchBackward(0, 8, program)
+
+	if !ok {
+		t.Fatalf("Expected to find a solution")
+	}
+
+	m.Reset(result)
+	m.Run()
+
+	if !slices.Equal(m.Output, program) {
+		t.Errorf("Expected %v, got %v", program, m.Output)
+	}
+
+	if _, ok := m.SearchBackward(0, 8, []int{1, 1, 1}); ok {
+		t.Errorf("Expected no solution")
+	}
+}
@@ -0,0 +1,3 @@
+module github.com/jmugliston/aoc/vm
+
+go 1.23
@@ -0,0 +1,230 @@
+package vm
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// A tiny CPU for assembly-style puzzles - programs are lists of ints, where each
+// opcode is followed by its operands, and the instruction set says what they do
+
+var ErrStepLimit = errors.New("Step limit reached")
+var ErrBreakpoint = errors.New("Breakpoint reached")
+
+// How to turn a raw operand into a value, and how to show it when disassembling
+type OperandMode struct {
+	Decode func(m *Machine, raw int) int
+	Format func(m *Machine, raw int) string
+}
+
+// The raw value itself
+var Literal = OperandMode{
+	Decode: func(m *Machine, raw int) int { return raw },
+	Format: func(m *Machine, raw int) string { return strconv.Itoa(raw) },
+}
+
+// The value of the register with that index
+var Register = OperandMode{
+	Decode: func(m *Machine, raw int) int { return m.Registers[raw] },
+	Format: func(m *Machine, raw int) string { return m.registerName(raw) },
+}
+
+// An operand that takes up space but isn't used
+var Ignored = OperandMode{
+	Decode: func(m *Machine, raw int) int { return raw },
+	Format: func(m *Machine, raw int) string { return "" },
+}
+
+type Instruction struct {
+	Name     string
+	Operands []OperandMode
+	// Called with the decoded operands, after the instruction pointer has moved past
+	// the instruction - so jumps just set m.IP
+	Execute func(m *Machine, operands []int)
+}
+
+// Instructions by opcode
+type InstructionSet map[int]Instruction
+
+type Machine struct {
+	Instructions  InstructionSet
+	RegisterNames []string
+	Registers     []int
+	Program       []int
+	// Address of the next instruction
+	IP int
+	// Address of the instruction being executed
+	Current int
+	Output  []int
+	// Number of instructions executed
+	Steps int
+	// Stop running after this many steps (0 for no limit)
+	StepLimit int
+	// Write each instruction (and the registers before it runs) here
+	Trace       io.Writer
+	breakpoints map[int]bool
+}
+
+// A machine with a register for each name (all 0)
+func New(instructions InstructionSet, registerNames []string, program []int) *Machine {
+	return &Machine{
+		Instructions:  instructions,
+		RegisterNames: registerNames,
+		Registers:     make([]int, len(registerNames)),
+		Program:       program,
+		breakpoints:   map[int]bool{},
+	}
+}
+
+// Back to the start of the program with new register values (missing registers are 0)
+func (m *Machine) Reset(registers ...int) {
+	clear(m.Registers)
+	copy(m.Registers, registers)
+	m.IP = 0
+	m.Current = 0
+	m.Output = nil
+	m.Steps = 0
+}
+
+func (m *Machine) registerName(index int) string {
+	if index >= 0 && index < len(m.RegisterNames) {
+		return m.RegisterNames[index]
+	}
+	return fmt.Sprintf("r%d", index)
+}
+
+func (m *Machine) registerIndex(name string) int {
+	for i, n := range m.RegisterNames {
+		if n == name {
+			return i
+		}
+	}
+	panic(fmt.Sprintf("Unknown register %s", name))
+}
+
+func (m *Machine) Get(name string) int {
+	return m.Registers[m.registerIndex(name)]
+}
+
+func (m *Machine) Set(name string, value int) {
+	m.Registers[m.registerIndex(name)] = value
+}
+
+// Add a value to the output
+func (m *Machine) Out(value int) {
+	m.Output = append(m.Output, value)
+}
+
+// Stop before running the instruction at an address
+func (m *Machine) AddBreakpoint(address int) {
+	m.breakpoints[address] = true
+}
+
+func (m *Machine) RemoveBreakpoint(address int) {
+	delete(m.breakpoints, address)
+}
+
+// Whether the instruction pointer has left the program (or the next instruction is cut off)
+func (m *Machine) Halted() bool {
+	if m.IP < 0 || m.IP >= len(m.Program) {
+		return true
+	}
+	instruction, ok := m.Instructions[m.Program[m.IP]]
+	return ok && m.IP+len(instruction.Operands) >= len(m.Program)
+}
+
+// Run a single instruction
+func (m *Machine) Step() error {
+	if m.Halted() {
+		return nil
+	}
+
+	opcode := m.Program[m.IP]
+	instruction, ok := m.Instructions[opcode]
+	if !ok {
+		return fmt.Errorf("Unknown opcode %d at %d", opcode, m.IP)
+	}
+
+	if m.Trace != nil {
+		fmt.Fprintf(m.Trace, "%-24s %s\n", m.disassembleAt(m.IP), m.registerString())
+	}
+
+	m.Current = m.IP
+	raw := m.Program[m.IP+1 : m.IP+1+len(instruction.Operands)]
+	m.IP += 1 + len(instruction.Operands)
+
+	operands := make([]int, len(raw))
+	for i, mode := range instruction.Operands {
+		operands[i] = mode.Decode(m, raw[i])
+	}
+
+	instruction.Execute(m, operands)
+	m.Steps++
+
+	return nil
+}
+
+// Run until the program halts, hits a breakpoint or reaches the step limit
+// A breakpoint at the current instruction is skipped, so calling Run again continues
+func (m *Machine) Run() error {
+	first := true
+	for !m.Halted() {
+		if m.breakpoints[m.IP] && !first {
+			return ErrBreakpoint
+		}
+		if m.StepLimit > 0 && m.Steps >= m.StepLimit {
+			return ErrStepLimit
+		}
+		if err := m.Step(); err != nil {
+			return err
+		}
+		first = false
+	}
+	return nil
+}
+
+func (m *Machine) registerString() string {
+	values := make([]string, len(m.Registers))
+	for i, value := range m.Registers {
+		values[i] = fmt.Sprintf("%s=%d", m.registerName(i), value)
+	}
+	return strings.Join(values, " ")
+}
+
+// The instruction at an address as text, e.g. "   4: jnz 0"
+func (m *Machine) disassembleAt(address int) string {
+	opcode := m.Program[address]
+	instruction, ok := m.Instructions[opcode]
+	if !ok || address+len(instruction.Operands) >= len(m.Program) {
+		return fmt.Sprintf("%4d: data %d", address, opcode)
+	}
+
+	operands := []string{}
+	for i, mode := range instruction.Operands {
+		if text := mode.Format(m, m.Program[address+1+i]); text != "" {
+			operands = append(operands, text)
+		}
+	}
+
+	return strings.TrimRight(fmt.Sprintf("%4d: %s %s", address, instruction.Name, strings.Join(operands, ", ")), " ")
+}
+
+// The whole program as readable text, one instruction per line
+func (m *Machine) Disassemble() string {
+	var output strings.Builder
+	for address := 0; address < len(m.Program); {
+		output.WriteString(m.disassembleAt(address))
+		output.WriteString("\n")
+
+		instruction, ok := m.Instructions[m.Program[address]]
+		if !ok {
+			address++
+			continue
+		}
+		address += 1 + len(instruction.Operands)
+	}
+	return output.String()
+}
@@ -0,0 +1,101 @@
+package vm
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// 2015 day 23 - with jump offsets counted in program words
+var turingLock = InstructionSet{
+	0: {Name: "hlf", Operands: []OperandMode{Ignored}, Execute: func(m *Machine, ops []int) {}},
+	1: {Name: "tpl", Operands: []OperandMode{Literal}, Execute: func(m *Machine, ops []int) { m.Registers[ops[0]] *= 3 }},
+	2: {Name: "inc", Operands: []OperandMode{Literal}, Execute: func(m *Machine, ops []int) { m.Registers[ops[0]]++ }},
+	3: {Name: "jmp", Operands: []OperandMode{Literal}, Execute: func(m *Machine, ops []int) { m.IP = m.Current + ops[0] }},
+	5: {Name: "jio", Operands: []OperandMode{Register, Literal}, Execute: func(m *Machine, ops []int) {
+		if ops[0] == 1 {
+			m.IP = m.Current + ops[1]
+		}
+	}},
+	6: {Name: "out", Operands: []OperandMode{Register}, Execute: func(m *Machine, ops []int) { m.Out(ops[0]) }},
+}
+
+func TestRun(t *testing.T) {
+	// inc a, jio a +5, tpl a, inc a, out a
+	m := New(turingLock, []string{"a", "b"}, []int{2, 0, 5, 0, 5, 1, 0, 2, 0, 6, 0})
+
+	if err := m.Run(); err != nil {
+		t.Fatal(err)
+	}
+
+	if m.Get("a") != 2 || !slices.Equal(m.Output, []int{2}) || m.Steps != 4 {
+		t.Errorf("Expected %v, got %v (after %v steps)", 2, m.Get("a"), m.Steps)
+	}
+
+	m.Reset(0, 7)
+
+	if m.Get("b") != 7 || m.IP != 0 || m.Output != nil {
+		t.Errorf("Expected the machine to be reset")
+	}
+}
+
+func TestStepLimitAndBreakpoints(t *testing.T) {
+	// inc a, jmp -2
+	m := New(turingLock, []string{"a"}, []int{2, 0, 3, -2})
+	m.StepLimit = 100
+
+	if err := m.Run(); !errors.Is(err, ErrStepLimit) {
+		t.Errorf("Expected %v, got %v", ErrStepLimit, err)
+	}
+
+	if m.Get("a") != 50 {
+		t.Errorf("Expected %v, got %v", 50, m.Get("a"))
+	}
+
+	m.Reset()
+	m.StepLimit = 0
+	m.AddBreakpoint(2)
+
+	for i := 1; i <= 3; i++ {
+		if err := m.Run(); !errors.Is(err, ErrBreakpoint) || m.Get("a") != i {
+			t.Errorf("Expected to stop at the breakpoint with a=%v, got %v (%v)", i, m.Get("a"), err)
+		}
+	}
+}
+
+func TestTraceAndDisassemble(t *testing.T) {
+	m := New(turingLock, []string{"a", "b"}, []int{2, 1, 5, 1, 5, 0, 9, 6, 1})
+
+	expected := `   0: inc 1
+   2: jio b, 5
+   5: hlf
+   7: out b
+`
+	result := m.Disassemble()
+
+	if result != expected {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+
+	var trace strings.Builder
+	m.Trace = &trace
+
+	m.Run()
+
+	lines := strings.Split(strings.TrimSpace(trace.String()), "\n")
+
+	if len(lines) != 3 || !strings.HasSuffix(lines[1], "a=0 b=1") {
+		t.Errorf("Unexpected trace %v", trace.String())
+	}
+
+	m = New(turingLock, []string{"a"}, []int{4, 0, 0})
+
+	if m.Disassemble() != "   0: data 4\n   1: hlf\n" {
+		t.Errorf("Unexpected disassembly %v", m.Disassemble())
+	}
+
+	if err := m.Run(); err == nil {
+		t.Errorf("Expected an error for an unknown opcode")
+	}
+}